@@ -0,0 +1,72 @@
+package contract
+
+import (
+	"sync"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/config/ufo/internal/model"
+)
+
+const concurrentWriters = 10
+
+// TestConcurrentUpdatesOfDifferentFieldsAreNotLost частичные обновления разных полей
+// не перезаписывают друг друга
+func (s *UFORepositorySuite) TestConcurrentUpdatesOfDifferentFieldsAreNotLost() {
+	id := s.create(sightingInfo(time.Now()))
+
+	updates := []model.SightingUpdateInfo{
+		{Location: ptr("Параллельное место")},
+		{Description: ptr("Параллельное описание")},
+		{Color: ptr("синий")},
+		{Sound: ptr(false)},
+		{DurationSeconds: ptr(int32(7))},
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(updates))
+	for _, updateInfo := range updates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			errs <- s.repo.Update(s.ctx, id, updateInfo)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		s.NoError(err)
+	}
+
+	stored := s.get(id)
+	s.Equal("Параллельное место", stored.Info.Location)
+	s.Equal("Параллельное описание", stored.Info.Description)
+	s.Equal(ptr("синий"), stored.Info.Color)
+	s.Equal(ptr(false), stored.Info.Sound)
+	s.Equal(ptr(int32(7)), stored.Info.DurationSeconds)
+}
+
+func (s *UFORepositorySuite) TestConcurrentCreatesAreAllStored() {
+	var wg sync.WaitGroup
+	ids := make(chan string, concurrentWriters)
+	for range concurrentWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			id, err := s.repo.Create(s.ctx, sightingInfo(time.Now()))
+			s.NoError(err)
+			ids <- id
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	unique := make(map[string]struct{}, concurrentWriters)
+	for id := range ids {
+		unique[id] = struct{}{}
+		s.get(id)
+	}
+	s.Len(unique, concurrentWriters)
+}
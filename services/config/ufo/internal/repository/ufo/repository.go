@@ -19,8 +19,13 @@ type repository struct {
 }
 
 func NewRepository(client *mongo.Client) *repository {
+	return newRepository(client, client.Database(databaseName))
+}
+
+// newRepository работает с коллекцией в базе db; тесты передают отдельную временную базу
+func newRepository(client *mongo.Client, db *mongo.Database) *repository {
 	return &repository{
 		client:     client,
-		collection: client.Database(databaseName).Collection(collectionName),
+		collection: db.Collection(collectionName),
 	}
 }
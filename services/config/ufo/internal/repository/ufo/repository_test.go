@@ -0,0 +1,87 @@
+package ufo
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+
+	def "github.com/baizhigit/go-ms-examples/config/ufo/internal/repository"
+	"github.com/baizhigit/go-ms-examples/config/ufo/internal/repository/contract"
+)
+
+const (
+	// testMongoURIEnv адрес MongoDB для контрактных тестов. Если переменная задана,
+	// недоступный MongoDB - ошибка теста, а не пропуск
+	testMongoURIEnv     = "UFO_TEST_MONGO_URI"
+	defaultTestMongoURI = "mongodb://localhost:27017"
+
+	testConnectTimeout = 2 * time.Second
+)
+
+func TestRepositoryContract(t *testing.T) {
+	client := connectTestMongo(t)
+
+	suite.Run(t, contract.NewUFORepositorySuite(func(t *testing.T) def.UFORepository {
+		return newRepository(client, testDatabase(t, client))
+	}))
+}
+
+// testDatabase создает отдельную временную базу на тест, чтобы тесты не видели данные друг друга
+func testDatabase(t *testing.T, client *mongo.Client) *mongo.Database {
+	t.Helper()
+
+	db := client.Database("ufo_contract_" + strings.ReplaceAll(uuid.NewString(), "-", ""))
+	t.Cleanup(func() {
+		err := db.Drop(context.Background())
+		if err != nil {
+			t.Logf("failed to drop test database %s: %v", db.Name(), err)
+		}
+	})
+
+	return db
+}
+
+// connectTestMongo подключается к MongoDB из UFO_TEST_MONGO_URI. Без переменной тест
+// пропускается, если локальный mongod недоступен
+func connectTestMongo(t *testing.T) *mongo.Client {
+	t.Helper()
+
+	uri, required := os.LookupEnv(testMongoURIEnv)
+	if !required {
+		uri = defaultTestMongoURI
+	}
+	unavailable := t.Skipf
+	if required {
+		unavailable = t.Fatalf
+	}
+
+	client, err := mongo.Connect(options.Client().ApplyURI(uri).SetServerSelectionTimeout(testConnectTimeout))
+	if err != nil {
+		unavailable("MongoDB is not available at %s: %v", uri, err)
+	}
+
+	t.Cleanup(func() {
+		err := client.Disconnect(context.Background())
+		if err != nil {
+			t.Logf("failed to disconnect from MongoDB: %v", err)
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), testConnectTimeout)
+	defer cancel()
+
+	err = client.Ping(ctx, readpref.Primary())
+	if err != nil {
+		unavailable("MongoDB is not available at %s: %v", uri, err)
+	}
+
+	return client
+}
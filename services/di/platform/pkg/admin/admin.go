@@ -0,0 +1,211 @@
+// Package admin служебный HTTP сервер для оркестратора и эксплуатации:
+// liveness, readiness, метрики Prometheus, уровень логирования и pprof.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/closer"
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+)
+
+const (
+	// readHeaderTimeout защищает от медленных клиентов, которые не дописывают заголовки
+	readHeaderTimeout = 5 * time.Second
+
+	// TokenHeader заголовок с токеном для PUT /loglevel и /debug/pprof/
+	TokenHeader = "X-Admin-Token"
+)
+
+// HealthStatus источник состояния для /readyz, например *health.Server
+type HealthStatus interface {
+	Status(service string) (grpc_health_v1.HealthCheckResponse_ServingStatus, bool)
+}
+
+type Options struct {
+	// Address адрес HTTP сервера, например ":8081"
+	Address string
+	// EnablePprof регистрирует /debug/pprof/: профили раскрывают внутренности процесса,
+	// поэтому по умолчанию выключены
+	EnablePprof bool
+	// Token требуется в заголовке TokenHeader для смены уровня логирования и pprof: адрес сервера
+	// открыт пробам Kubernetes, а значит, и всем в кластере. Пустой токен отключает эти эндпоинты
+	Token string
+	// ShutdownTimeout время на завершение текущих запросов при остановке, после чего соединения
+	// закрываются принудительно: профиль pprof может выполняться дольше всего бюджета на shutdown.
+	// 0 - ждать, пока не истечет общий контекст closer
+	ShutdownTimeout time.Duration
+}
+
+// Server служебный HTTP сервер:
+//   - GET /healthz - liveness, 200 пока процесс отвечает
+//   - GET /readyz - readiness по health registry: 200 при SERVING, иначе 503; ?service= выбирает сервис
+//   - GET /metrics - метрики Prometheus
+//   - GET, PUT /loglevel - текущий уровень логирования и его смена через {"level": "debug"} (с токеном)
+//   - /debug/pprof/ - профилирование, если включено (с токеном)
+type Server struct {
+	httpServer      *http.Server
+	health          HealthStatus
+	token           string
+	shutdownTimeout time.Duration
+}
+
+func NewServer(options Options, health HealthStatus) *Server {
+	s := &Server{health: health, token: options.Token, shutdownTimeout: options.ShutdownTimeout}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", s.healthz)
+	mux.HandleFunc("GET /readyz", s.readyz)
+	mux.Handle("GET /metrics", promhttp.Handler())
+	mux.HandleFunc("GET /loglevel", s.getLogLevel)
+	mux.HandleFunc("PUT /loglevel", s.requireToken(s.setLogLevel))
+
+	if options.EnablePprof {
+		mux.HandleFunc("/debug/pprof/", s.requireToken(pprof.Index))
+		mux.HandleFunc("/debug/pprof/cmdline", s.requireToken(pprof.Cmdline))
+		mux.HandleFunc("/debug/pprof/profile", s.requireToken(pprof.Profile))
+		mux.HandleFunc("/debug/pprof/symbol", s.requireToken(pprof.Symbol))
+		mux.HandleFunc("/debug/pprof/trace", s.requireToken(pprof.Trace))
+	}
+
+	s.httpServer = &http.Server{
+		Addr:              options.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	return s
+}
+
+// Start занимает адрес сразу, чтобы ошибка вернулась при запуске приложения, и обслуживает
+// запросы в отдельной горутине. Сервер останавливается в фазе closer.PhaseDrain: /readyz
+// продолжает отвечать 503, пока балансировщики убирают экземпляр.
+func (s *Server) Start(ctx context.Context) error {
+	lis, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("admin server: %w", err)
+	}
+
+	closer.AddPhase(closer.PhaseDrain, "admin HTTP server", s.shutdownTimeout, s.shutdown)
+
+	go func() {
+		logger.Info(ctx, fmt.Sprintf("🩺 Admin HTTP server listening on %s", lis.Addr()))
+
+		err := s.httpServer.Serve(lis)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(ctx, "admin HTTP server failed", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+// shutdown дожидается текущих запросов, а по истечении ctx обрывает оставшиеся соединения,
+// чтобы долгий запрос не занимал время следующих фаз
+func (s *Server) shutdown(ctx context.Context) error {
+	err := s.httpServer.Shutdown(ctx)
+	if err != nil && ctx.Err() != nil {
+		return errors.Join(err, s.httpServer.Close())
+	}
+
+	return err
+}
+
+// requireToken пропускает запрос только с токеном администратора в заголовке TokenHeader
+func (s *Server) requireToken(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.token == "" {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "admin endpoints are disabled"})
+			return
+		}
+
+		token := r.Header.Get(TokenHeader)
+		if token == "" {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "missing " + TokenHeader + " header"})
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "invalid admin token"})
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (s *Server) healthz(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Server) readyz(w http.ResponseWriter, r *http.Request) {
+	service := r.URL.Query().Get("service")
+
+	servingStatus, ok := s.health.Status(service)
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": fmt.Sprintf("unknown service %q", service)})
+		return
+	}
+
+	code := http.StatusOK
+	if servingStatus != grpc_health_v1.HealthCheckResponse_SERVING {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, code, map[string]string{"status": servingStatus.String()})
+}
+
+// logLevel тело запросов /loglevel
+type logLevel struct {
+	Level string `json:"level"`
+}
+
+func (s *Server) getLogLevel(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, logLevel{Level: logger.Level()})
+}
+
+func (s *Server) setLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req logLevel
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid JSON body"})
+		return
+	}
+
+	// Проверяем сами: logger.SetLevel молча заменяет неизвестный уровень на info
+	level := strings.ToLower(req.Level)
+	switch level {
+	case "debug", "info", "warn", "error":
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{
+			"error": fmt.Sprintf("unknown level %q, expected debug, info, warn or error", req.Level),
+		})
+		return
+	}
+
+	logger.SetLevel(level)
+	logger.Info(r.Context(), "log level changed", zap.String("level", level))
+
+	writeJSON(w, http.StatusOK, logLevel{Level: logger.Level()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Error(context.Background(), "failed to write admin response", zap.Error(err))
+	}
+}
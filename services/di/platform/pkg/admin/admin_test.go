@@ -0,0 +1,170 @@
+package admin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+)
+
+// fakeHealth состояние сервисов для /readyz
+type fakeHealth map[string]grpc_health_v1.HealthCheckResponse_ServingStatus
+
+func (f fakeHealth) Status(service string) (grpc_health_v1.HealthCheckResponse_ServingStatus, bool) {
+	servingStatus, ok := f[service]
+	return servingStatus, ok
+}
+
+// testToken токен администратора в тестах
+const testToken = "secret"
+
+func serve(t *testing.T, s *Server, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	return serveWithToken(t, s, method, target, body, testToken)
+}
+
+func serveWithToken(t *testing.T, s *Server, method, target, body, token string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set(TokenHeader, token)
+	}
+
+	rec := httptest.NewRecorder()
+	s.httpServer.Handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestReadyz(t *testing.T) {
+	s := NewServer(Options{}, fakeHealth{
+		"":                  grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		"ufo.v1.UFOService": grpc_health_v1.HealthCheckResponse_SERVING,
+	})
+
+	tests := []struct {
+		target string
+		code   int
+	}{
+		{target: "/healthz", code: http.StatusOK},
+		{target: "/readyz", code: http.StatusServiceUnavailable},
+		{target: "/readyz?service=ufo.v1.UFOService", code: http.StatusOK},
+		{target: "/readyz?service=unknown", code: http.StatusNotFound},
+		{target: "/debug/pprof/", code: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		if rec := serve(t, s, http.MethodGet, tt.target, ""); rec.Code != tt.code {
+			t.Errorf("GET %s = %d, want %d", tt.target, rec.Code, tt.code)
+		}
+	}
+}
+
+func TestLogLevel(t *testing.T) {
+	if err := logger.Init("info", true); err != nil {
+		t.Fatalf("logger.Init: %v", err)
+	}
+	defer logger.SetLevel("info")
+
+	s := NewServer(Options{EnablePprof: true, Token: testToken}, fakeHealth{})
+
+	rec := serve(t, s, http.MethodPut, "/loglevel", `{"level": "verbose"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("PUT unknown level = %d, want 400", rec.Code)
+	}
+
+	rec = serve(t, s, http.MethodPut, "/loglevel", `{"level": "DEBUG"}`)
+	if rec.Code != http.StatusOK || logger.Level() != "debug" {
+		t.Fatalf("PUT debug = %d, level %q", rec.Code, logger.Level())
+	}
+
+	rec = serve(t, s, http.MethodGet, "/loglevel", "")
+	if !strings.Contains(rec.Body.String(), `"level":"debug"`) {
+		t.Fatalf("GET /loglevel = %s", rec.Body.String())
+	}
+
+	if rec := serve(t, s, http.MethodGet, "/debug/pprof/", ""); rec.Code != http.StatusOK {
+		t.Fatalf("GET /debug/pprof/ = %d, want 200", rec.Code)
+	}
+}
+
+func TestAdminEndpointsRequireToken(t *testing.T) {
+	logger.SetNopLogger()
+	defer logger.SetLevel("info")
+
+	tests := []struct {
+		name        string
+		serverToken string
+		token       string
+		code        int
+	}{
+		{name: "disabled without server token", serverToken: "", token: testToken, code: http.StatusForbidden},
+		{name: "missing header", serverToken: testToken, token: "", code: http.StatusUnauthorized},
+		{name: "wrong token", serverToken: testToken, token: "wrong", code: http.StatusForbidden},
+		{name: "valid token", serverToken: testToken, token: testToken, code: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(Options{EnablePprof: true, Token: tt.serverToken}, fakeHealth{})
+
+			if rec := serveWithToken(t, s, http.MethodPut, "/loglevel", `{"level": "warn"}`, tt.token); rec.Code != tt.code {
+				t.Errorf("PUT /loglevel = %d, want %d", rec.Code, tt.code)
+			}
+			if rec := serveWithToken(t, s, http.MethodGet, "/debug/pprof/", "", tt.token); rec.Code != tt.code {
+				t.Errorf("GET /debug/pprof/ = %d, want %d", rec.Code, tt.code)
+			}
+			// Чтение уровня и пробы токена не требуют
+			if rec := serveWithToken(t, s, http.MethodGet, "/loglevel", "", ""); rec.Code != http.StatusOK {
+				t.Errorf("GET /loglevel = %d, want 200", rec.Code)
+			}
+		})
+	}
+}
+
+func TestShutdownClosesStuckConnections(t *testing.T) {
+	s := NewServer(Options{}, fakeHealth{})
+
+	// Запрос, который не завершается сам, как долгий профиль pprof
+	started := make(chan struct{})
+	s.httpServer.Handler = http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go func() { _ = s.httpServer.Serve(lis) }()
+
+	requestDone := make(chan struct{})
+	go func() {
+		defer close(requestDone)
+		resp, err := http.Get("http://" + lis.Addr().String() + "/profile")
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := s.shutdown(ctx); err == nil {
+		t.Fatal("shutdown with a stuck request returned nil, want deadline error")
+	}
+
+	select {
+	case <-requestDone:
+	case <-time.After(time.Second):
+		t.Fatal("stuck connection was not closed after shutdown timeout")
+	}
+}
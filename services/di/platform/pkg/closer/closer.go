@@ -1,11 +1,13 @@
 package closer
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/signal"
+	"slices"
 	"sync"
 	"time"
 
@@ -17,18 +19,60 @@ import (
 // shutdownTimeout по умолчанию, можно сделать параметром
 const shutdownTimeout = 5 * time.Second
 
+// cancelGrace сколько ждать функцию после истечения ее контекста: функции, которые реагируют
+// на отмену (например, принудительно останавливают сервер), успевают завершиться
+const cancelGrace = 100 * time.Millisecond
+
 type Logger interface {
 	Info(ctx context.Context, msg string, fields ...zap.Field)
 	Error(ctx context.Context, msg string, fields ...zap.Field)
 }
 
+// Phase фаза завершения работы. Фазы выполняются по возрастанию: сначала приложение перестает
+// принимать запросы, затем дожидается текущих, сбрасывает буферы и только потом закрывает хранилища
+type Phase int
+
+const (
+	// PhaseStopAccepting перестать принимать новые запросы (health, балансировщик)
+	PhaseStopAccepting Phase = iota
+	// PhaseDrain дождаться обработки текущих запросов и остановить серверы
+	PhaseDrain
+	// PhaseFlush сбросить буферы и фоновые очереди
+	PhaseFlush
+	// PhaseCloseStores закрыть соединения с хранилищами и файлы; фаза по умолчанию для Add и AddNamed
+	PhaseCloseStores
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseStopAccepting:
+		return "stop accepting"
+	case PhaseDrain:
+		return "drain"
+	case PhaseFlush:
+		return "flush"
+	case PhaseCloseStores:
+		return "close stores"
+	default:
+		return fmt.Sprintf("phase %d", int(p))
+	}
+}
+
+// closeFunc зарегистрированная функция закрытия
+type closeFunc struct {
+	phase   Phase
+	name    string
+	timeout time.Duration
+	f       func(context.Context) error
+}
+
 // Closer управляет процессом graceful shutdown приложения
 type Closer struct {
-	mu     sync.Mutex                    // Защита от гонки при добавлении функций
-	once   sync.Once                     // Гарантия однократного вызова CloseAll
-	done   chan struct{}                 // Канал для оповещения о завершении
-	funcs  []func(context.Context) error // Зарегистрированные функции закрытия
-	logger Logger                        // Используемый логгер
+	mu     sync.Mutex    // Защита от гонки при добавлении функций
+	once   sync.Once     // Гарантия однократного вызова CloseAll
+	done   chan struct{} // Канал для оповещения о завершении
+	funcs  []closeFunc   // Зарегистрированные функции закрытия
+	logger Logger        // Используемый логгер
 }
 
 // Глобальный экземпляр для использования по всему приложению
@@ -39,6 +83,11 @@ func AddNamed(name string, f func(context.Context) error) {
 	globalCloser.AddNamed(name, f)
 }
 
+// AddPhase добавляет именованную функцию закрытия в фазу phase глобального closer'а
+func AddPhase(phase Phase, name string, timeout time.Duration, f func(context.Context) error) {
+	globalCloser.AddPhase(phase, name, timeout, f)
+}
+
 // Add добавляет функции закрытия в глобальный closer
 func Add(f ...func(context.Context) error) {
 	globalCloser.Add(f...)
@@ -106,35 +155,34 @@ func (c *Closer) handleSignals(signals ...os.Signal) {
 	}
 }
 
-// AddNamed добавляет функцию закрытия с именем зависимости для логирования
+// AddNamed добавляет функцию закрытия с именем зависимости для логирования в фазу PhaseCloseStores
 func (c *Closer) AddNamed(name string, f func(context.Context) error) {
-	c.Add(func(ctx context.Context) error {
-		start := time.Now()
-		c.logger.Info(ctx, fmt.Sprintf("🧩 Закрываем %s...", name))
-
-		err := f(ctx)
+	c.AddPhase(PhaseCloseStores, name, 0, f)
+}
 
-		duration := time.Since(start)
-		if err != nil {
-			c.logger.Error(ctx, fmt.Sprintf("❌ Ошибка при закрытии %s: %v (заняло %s)", name, err, duration))
-		} else {
-			c.logger.Info(ctx, fmt.Sprintf("✅ %s успешно закрыт за %s", name, duration))
-		}
-		return err
-	})
+// AddPhase добавляет именованную функцию закрытия в фазу phase. Если timeout больше нуля,
+// функция получает контекст с этим ограничением и по его истечении больше не ожидается
+func (c *Closer) AddPhase(phase Phase, name string, timeout time.Duration, f func(context.Context) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.funcs = append(c.funcs, closeFunc{phase: phase, name: name, timeout: timeout, f: f})
 }
 
-// Add добавляет одну или несколько функций закрытия
+// Add добавляет одну или несколько функций закрытия в фазу PhaseCloseStores
 func (c *Closer) Add(f ...func(context.Context) error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.funcs = append(c.funcs, f...)
+	for _, fn := range f {
+		c.funcs = append(c.funcs, closeFunc{phase: PhaseCloseStores, f: fn})
+	}
 }
 
-// CloseAll вызывает все зарегистрированные функции закрытия.
-// Возвращает первую возникшую ошибку, если таковая была.
+// CloseAll вызывает все зарегистрированные функции закрытия по фазам. Внутри фазы функции
+// выполняются последовательно в обратном порядке добавления: зависимость, добавленная раньше,
+// закрывается позже. Следующая фаза начинается только после завершения предыдущей.
+// Возвращает все возникшие ошибки, объединенные errors.Join.
 func (c *Closer) CloseAll(ctx context.Context) error {
-	var result error
+	var errs []error
 
 	c.once.Do(func() {
 		defer close(c.done)
@@ -151,57 +199,87 @@ func (c *Closer) CloseAll(ctx context.Context) error {
 
 		c.logger.Info(ctx, "🚦 Начинаем процесс graceful shutdown...")
 
-		errCh := make(chan error, len(funcs))
-		var wg sync.WaitGroup
-
-		// Выполняем в обратном порядке добавления
-		for i := len(funcs) - 1; i >= 0; i-- {
-			f := funcs[i]
-			wg.Add(1)
-			go func(f func(context.Context) error) {
-				defer wg.Done()
-
-				// Защита от паники
-				defer func() {
-					if r := recover(); r != nil {
-						errCh <- errors.New("panic recovered in closer")
-						c.logger.Error(ctx, "⚠️ Panic в функции закрытия", zap.Any("error", r))
-					}
-				}()
-
-				if err := f(ctx); err != nil {
-					errCh <- err
-				}
-			}(f)
-		}
+		// Стабильная сортировка сохраняет порядок добавления внутри фазы
+		slices.SortStableFunc(funcs, func(a, b closeFunc) int {
+			return cmp.Compare(a.phase, b.phase)
+		})
 
-		// Закрываем канал ошибок, когда все функции завершатся
-		go func() {
-			wg.Wait()
-			close(errCh)
-		}()
+		for start := 0; start < len(funcs); {
+			end := start
+			for end < len(funcs) && funcs[end].phase == funcs[start].phase {
+				end++
+			}
 
-		// Читаем ошибки или отмену контекста
-		for {
-			select {
-			case <-ctx.Done():
-				c.logger.Info(ctx, "⚠️ Контекст отменён во время закрытия", zap.Error(ctx.Err()))
-				if result == nil {
-					result = ctx.Err()
-				}
-				return
-			case err, ok := <-errCh:
-				if !ok {
-					c.logger.Info(ctx, "✅ Все ресурсы успешно закрыты")
-					return
-				}
-				c.logger.Error(ctx, "❌ Ошибка при закрытии", zap.Error(err))
-				if result == nil {
-					result = err
+			c.logger.Info(ctx, fmt.Sprintf("🚦 Фаза %s", funcs[start].phase))
+			for i := end - 1; i >= start; i-- {
+				if err := c.run(ctx, funcs[i]); err != nil {
+					errs = append(errs, err)
 				}
 			}
+
+			start = end
+		}
+
+		if len(errs) == 0 {
+			c.logger.Info(ctx, "✅ Все ресурсы успешно закрыты")
 		}
 	})
 
-	return result
+	return errors.Join(errs...)
+}
+
+// run выполняет одну функцию закрытия. Функция, не уложившаяся в отведенное время, дальше не ожидается,
+// чтобы зависшая зависимость не мешала закрыть остальные. Ошибки дополняются именем зависимости.
+func (c *Closer) run(ctx context.Context, cf closeFunc) error {
+	if cf.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cf.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	if cf.name != "" {
+		c.logger.Info(ctx, fmt.Sprintf("🧩 Закрываем %s...", cf.name))
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		// Защита от паники
+		defer func() {
+			if r := recover(); r != nil {
+				c.logger.Error(ctx, "⚠️ Panic в функции закрытия", zap.Any("error", r))
+				done <- fmt.Errorf("panic recovered in closer: %v", r)
+			}
+		}()
+
+		done <- cf.f(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		select {
+		case err = <-done:
+		case <-time.After(cancelGrace):
+			err = fmt.Errorf("not closed in time: %w", ctx.Err())
+		}
+	}
+
+	if cf.name == "" {
+		if err != nil {
+			c.logger.Error(ctx, "❌ Ошибка при закрытии", zap.Error(err))
+		}
+		return err
+	}
+
+	duration := time.Since(start)
+	if err != nil {
+		c.logger.Error(ctx, fmt.Sprintf("❌ Ошибка при закрытии %s: %v (заняло %s)", cf.name, err, duration))
+		return fmt.Errorf("%s: %w", cf.name, err)
+	}
+
+	c.logger.Info(ctx, fmt.Sprintf("✅ %s успешно закрыт за %s", cf.name, duration))
+
+	return nil
 }
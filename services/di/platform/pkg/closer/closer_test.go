@@ -0,0 +1,88 @@
+package closer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+)
+
+func TestCloseAllRunsPhasesInOrder(t *testing.T) {
+	c := NewWithLogger(&logger.NoopLogger{})
+
+	var order []string
+	record := func(name string) func(context.Context) error {
+		return func(context.Context) error {
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	c.AddNamed("mongo", record("mongo"))
+	c.AddPhase(PhaseDrain, "listener", 0, record("listener"))
+	c.AddPhase(PhaseDrain, "grpc", 0, record("grpc"))
+	c.AddPhase(PhaseFlush, "relay", 0, record("relay"))
+	c.AddPhase(PhaseStopAccepting, "health", 0, record("health"))
+
+	if err := c.CloseAll(context.Background()); err != nil {
+		t.Fatalf("CloseAll: %v", err)
+	}
+
+	want := []string{"health", "grpc", "listener", "relay", "mongo"}
+	if !slices.Equal(order, want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+}
+
+func TestCloseAllJoinsErrors(t *testing.T) {
+	c := NewWithLogger(&logger.NoopLogger{})
+
+	errFlush := errors.New("flush failed")
+	errStore := errors.New("disconnect failed")
+	closed := false
+
+	c.AddPhase(PhaseFlush, "relay", 0, func(context.Context) error { return errFlush })
+	c.AddPhase(PhaseFlush, "panicky", 0, func(context.Context) error { panic("boom") })
+	c.AddNamed("mongo", func(context.Context) error { return errStore })
+	c.AddNamed("file", func(context.Context) error {
+		closed = true
+		return nil
+	})
+
+	err := c.CloseAll(context.Background())
+	if !errors.Is(err, errFlush) || !errors.Is(err, errStore) {
+		t.Fatalf("CloseAll = %v, want both errors", err)
+	}
+	if !closed {
+		t.Fatal("functions after a failure were not called")
+	}
+}
+
+func TestCloseAllTimeout(t *testing.T) {
+	c := NewWithLogger(&logger.NoopLogger{})
+
+	stuck := make(chan struct{})
+	defer close(stuck)
+
+	closed := false
+	c.AddPhase(PhaseDrain, "stuck", 10*time.Millisecond, func(context.Context) error {
+		// Не реагирует на контекст
+		<-stuck
+		return nil
+	})
+	c.AddNamed("mongo", func(context.Context) error {
+		closed = true
+		return nil
+	})
+
+	err := c.CloseAll(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("CloseAll = %v, want deadline exceeded", err)
+	}
+	if !closed {
+		t.Fatal("later phase was not run after a timeout")
+	}
+}
@@ -2,31 +2,278 @@ package health
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/closer"
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
 )
 
-// Server implements the gRPC Health Checking Protocol (GRPC Health v1)
+// OverallService имя сервиса, под которым отдается состояние всего сервера
+const OverallService = ""
+
+// Probe проверяет одну зависимость: nil означает, что зависимость доступна
+type Probe func(ctx context.Context) error
+
+type Options struct {
+	// CheckInterval как часто выполнять пробы
+	CheckInterval time.Duration
+	// ProbeTimeout сколько ждать одну пробу
+	ProbeTimeout time.Duration
+	// DrainDelay сколько ждать после перехода в NOT_SERVING, прежде чем останавливать серверы,
+	// чтобы балансировщики успели перестать направлять запросы
+	DrainDelay time.Duration
+}
+
+type probe struct {
+	service string
+	name    string
+	check   Probe
+	err     error
+}
+
+// Server реализует gRPC Health Checking Protocol (GRPC Health v1) по результатам проб зависимостей.
+// Сервис в состоянии SERVING, если проходят все его пробы; общее состояние сервера (пустое имя сервиса)
+// учитывает пробы всех сервисов.
 type Server struct {
 	grpc_health_v1.UnimplementedHealthServer
+
+	options Options
+
+	mu           sync.Mutex
+	probes       []*probe
+	statuses     map[string]grpc_health_v1.HealthCheckResponse_ServingStatus
+	watchers     map[string]map[chan grpc_health_v1.HealthCheckResponse_ServingStatus]struct{}
+	shuttingDown bool
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func NewServer(options Options) *Server {
+	return &Server{
+		options: options,
+		statuses: map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{
+			OverallService: grpc_health_v1.HealthCheckResponse_SERVING,
+		},
+		watchers: make(map[string]map[chan grpc_health_v1.HealthCheckResponse_ServingStatus]struct{}),
+		stop:     make(chan struct{}),
+	}
+}
+
+// AddService объявляет сервис, у которого может не быть собственных проб
+func (s *Server) AddService(service string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.statuses[service]; !ok {
+		s.setStatus(service, s.aggregate(service))
+	}
+}
+
+// AddProbe добавляет именованную пробу зависимости сервиса. Новая проба считается пройденной
+// до первой проверки
+func (s *Server) AddProbe(service, name string, check Probe) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.probes = append(s.probes, &probe{service: service, name: name, check: check})
+	if _, ok := s.statuses[service]; !ok {
+		s.setStatus(service, s.aggregate(service))
+	}
+}
+
+// Start выполняет пробы сразу и затем каждые CheckInterval до Shutdown
+func (s *Server) Start() {
+	s.checkAll()
+
+	go func() {
+		ticker := time.NewTicker(s.options.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				s.checkAll()
+			}
+		}
+	}()
+}
+
+// Shutdown переводит все сервисы в NOT_SERVING, прекращает пробы и ждет DrainDelay, чтобы
+// балансировщики успели убрать экземпляр до остановки серверов. Состояние больше не меняется.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.shuttingDown = true
+	for service := range s.statuses {
+		s.setStatus(service, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
+	s.mu.Unlock()
+
+	s.stopOnce.Do(func() { close(s.stop) })
+
+	select {
+	case <-time.After(s.options.DrainDelay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Status возвращает текущее состояние сервиса; false - сервис неизвестен
+func (s *Server) Status(service string) (grpc_health_v1.HealthCheckResponse_ServingStatus, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	servingStatus, ok := s.statuses[service]
+	return servingStatus, ok
 }
 
 // Check implements the standard grpc health check protocol
-func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
-	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	}, nil
+func (s *Server) Check(_ context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	servingStatus, ok := s.Status(req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: servingStatus}, nil
 }
 
-// Watch implements the standard grpc health check protocol
+// Watch implements the standard grpc health check protocol: сразу отправляет текущее состояние
+// и затем каждое его изменение, пока клиент не закроет стрим
 func (s *Server) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
-	return stream.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	})
+	service := req.GetService()
+
+	// Буфер на одно значение: медленный клиент получает последнее состояние, а не всю историю
+	updates := make(chan grpc_health_v1.HealthCheckResponse_ServingStatus, 1)
+
+	s.mu.Lock()
+	if _, ok := s.watchers[service]; !ok {
+		s.watchers[service] = make(map[chan grpc_health_v1.HealthCheckResponse_ServingStatus]struct{})
+	}
+	s.watchers[service][updates] = struct{}{}
+
+	servingStatus, ok := s.statuses[service]
+	if !ok {
+		servingStatus = grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+	updates <- servingStatus
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.watchers[service], updates)
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case servingStatus := <-updates:
+			err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: servingStatus})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// checkAll выполняет все пробы и обновляет состояние сервисов
+func (s *Server) checkAll() {
+	s.mu.Lock()
+	probes := make([]*probe, len(s.probes))
+	copy(probes, s.probes)
+	s.mu.Unlock()
+
+	results := make([]error, len(probes))
+	for i, p := range probes {
+		results[i] = s.runProbe(p)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Пробы могли идти дольше, чем начался shutdown: его состояние не перезаписываем
+	if s.shuttingDown {
+		return
+	}
+
+	for i, p := range probes {
+		ctx := context.Background()
+		switch {
+		case results[i] != nil && p.err == nil:
+			logger.Warn(ctx, "health probe failed",
+				zap.String("service", p.service), zap.String("probe", p.name), zap.Error(results[i]))
+		case results[i] == nil && p.err != nil:
+			logger.Info(ctx, "health probe recovered", zap.String("service", p.service), zap.String("probe", p.name))
+		}
+		p.err = results[i]
+	}
+
+	for service := range s.statuses {
+		s.setStatus(service, s.aggregate(service))
+	}
+}
+
+func (s *Server) runProbe(p *probe) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.options.ProbeTimeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New("panic recovered in health probe")
+		}
+	}()
+
+	return p.check(ctx)
+}
+
+// aggregate состояние сервиса по последним результатам его проб. Вызывается под s.mu
+func (s *Server) aggregate(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if s.shuttingDown {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	for _, p := range s.probes {
+		if p.err != nil && (service == OverallService || p.service == service) {
+			return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+// setStatus сохраняет состояние сервиса и уведомляет подписчиков, если оно изменилось. Вызывается под s.mu
+func (s *Server) setStatus(service string, servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	previous, ok := s.statuses[service]
+	s.statuses[service] = servingStatus
+	if ok && previous == servingStatus {
+		return
+	}
+
+	for updates := range s.watchers[service] {
+		// Заменяем непрочитанное значение последним
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- servingStatus
+	}
 }
 
-// RegisterService registers the health service with the gRPC server
-func RegisterService(s *grpc.Server) {
-	grpc_health_v1.RegisterHealthServer(s, &Server{})
+// RegisterService регистрирует health service в gRPC сервере. В начале graceful shutdown
+// (фаза closer.PhaseStopAccepting) сервер переходит в NOT_SERVING
+func RegisterService(s *grpc.Server, hs *Server) {
+	grpc_health_v1.RegisterHealthServer(s, hs)
+	closer.AddPhase(closer.PhaseStopAccepting, "health server", 0, hs.Shutdown)
 }
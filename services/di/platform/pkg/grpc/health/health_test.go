@@ -0,0 +1,145 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+)
+
+const testService = "ufo.v1.UFOService"
+
+func init() {
+	// Пробы пишут в глобальный логгер; выводим его в "мусорный" writer
+	logger.InitForBenchmark()
+}
+
+func newTestClient(t *testing.T, hs *Server) grpc_health_v1.HealthClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(srv, hs)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+func TestCheckAggregatesProbes(t *testing.T) {
+	var failing atomic.Bool
+	hs := NewServer(Options{CheckInterval: time.Hour, ProbeTimeout: time.Second})
+	hs.AddService("other.Service")
+	hs.AddProbe(testService, "db", func(context.Context) error {
+		if failing.Load() {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	client := newTestClient(t, hs)
+	ctx := context.Background()
+
+	check := func(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+		t.Helper()
+		resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check(%q): %v", service, err)
+		}
+		return resp.GetStatus()
+	}
+
+	hs.checkAll()
+	if got := check(testService); got != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("status = %v, want SERVING", got)
+	}
+
+	failing.Store(true)
+	hs.checkAll()
+	if got := check(testService); got != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("status = %v, want NOT_SERVING", got)
+	}
+	if got := check(OverallService); got != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("overall status = %v, want NOT_SERVING", got)
+	}
+	if got := check("other.Service"); got != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("other service status = %v, want SERVING", got)
+	}
+
+	_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("Check(unknown) = %v, want NotFound", err)
+	}
+}
+
+func TestWatchStreamsChangesUntilShutdown(t *testing.T) {
+	var failing atomic.Bool
+	hs := NewServer(Options{CheckInterval: time.Hour, ProbeTimeout: time.Second})
+	hs.AddProbe(testService, "db", func(context.Context) error {
+		if failing.Load() {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	client := newTestClient(t, hs)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Watch(ctx, &grpc_health_v1.HealthCheckRequest{Service: testService})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	next := func(want grpc_health_v1.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		if resp.GetStatus() != want {
+			t.Fatalf("status = %v, want %v", resp.GetStatus(), want)
+		}
+	}
+
+	next(grpc_health_v1.HealthCheckResponse_SERVING)
+
+	failing.Store(true)
+	hs.checkAll()
+	next(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	failing.Store(false)
+	hs.checkAll()
+	next(grpc_health_v1.HealthCheckResponse_SERVING)
+
+	if err := hs.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	next(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	// После shutdown пробы больше не возвращают SERVING
+	hs.checkAll()
+	resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: testService})
+	if err != nil || resp.GetStatus() != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("Check after shutdown = %v, %v", resp.GetStatus(), err)
+	}
+}
@@ -0,0 +1,183 @@
+// Package interceptor содержит общие gRPC-перехватчики сервисов платформы.
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+)
+
+// Заголовки метаданных, по которым запрос коррелируется между сервисами
+const (
+	// RequestIDHeader идентификатор запроса; совпадает с trace_id в логах
+	RequestIDHeader = "x-request-id"
+	// TraceparentHeader заголовок W3C Trace Context: 00-<trace-id>-<parent-id>-<flags>
+	TraceparentHeader = "traceparent"
+	// UserIDHeader идентификатор пользователя, от имени которого выполняется запрос
+	UserIDHeader = "x-user-id"
+)
+
+// maxRequestIDLength более длинный x-request-id заменяется сгенерированным, чтобы не раздувать логи
+const maxRequestIDLength = 128
+
+// UnaryServerTrace кладет в контекст запроса trace_id и user_id для логгера. trace_id берется из
+// активного span OpenTelemetry, затем из traceparent, затем из x-request-id, иначе генерируется;
+// клиент получает его в заголовке x-request-id
+func UnaryServerTrace() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, traceID := incomingTrace(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, traceID))
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerTrace - то же для стримов
+func StreamServerTrace() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, traceID := incomingTrace(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, traceID))
+
+		return handler(srv, &traceStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryClientTrace передает trace_id и user_id из контекста в исходящие метаданные,
+// чтобы логи вызываемого сервиса коррелировали с логами вызывающего
+func UnaryClientTrace() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingTrace(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientTrace - то же для стримов
+func StreamClientTrace() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingTrace(ctx), desc, cc, method, opts...)
+	}
+}
+
+type traceStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *traceStream) Context() context.Context {
+	return s.ctx
+}
+
+func incomingTrace(ctx context.Context) (context.Context, string) {
+	// Span создает otelgrpc.NewServerHandler: его trace_id уже учитывает входящий traceparent
+	traceID, ok := spanTraceID(ctx)
+	if !ok {
+		traceID, ok = traceIDFromTraceparent(firstValue(ctx, TraceparentHeader))
+	}
+	if !ok {
+		traceID = firstValue(ctx, RequestIDHeader)
+	}
+	if traceID == "" || len(traceID) > maxRequestIDLength {
+		traceID = newID(16)
+	}
+
+	ctx = logger.WithTraceID(ctx, traceID)
+	if userID := firstValue(ctx, UserIDHeader); userID != "" {
+		ctx = logger.WithUserID(ctx, userID)
+	}
+
+	return ctx, traceID
+}
+
+// outgoingTrace добавляет заголовки, которых еще нет в исходящих метаданных: явно переданные
+// вызывающим значения не перезаписываются. При активном span traceparent строится из него;
+// otelgrpc.NewClientHandler затем заменяет его заголовком span исходящего вызова
+func outgoingTrace(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	var traceID, traceparent string
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		traceID = spanContext.TraceID().String()
+		traceparent = "00-" + traceID + "-" + spanContext.SpanID().String() + "-" + spanContext.TraceFlags().String()
+	} else {
+		traceID = logger.TraceIDFromContext(ctx)
+		// traceparent можно построить только из trace_id в формате W3C
+		if isTraceID(traceID) {
+			traceparent = "00-" + traceID + "-" + newID(8) + "-01"
+		}
+	}
+
+	var pairs []string
+	if traceID != "" && len(md.Get(RequestIDHeader)) == 0 {
+		pairs = append(pairs, RequestIDHeader, traceID)
+	}
+	if traceparent != "" && len(md.Get(TraceparentHeader)) == 0 {
+		pairs = append(pairs, TraceparentHeader, traceparent)
+	}
+	if userID := logger.UserIDFromContext(ctx); userID != "" && len(md.Get(UserIDHeader)) == 0 {
+		pairs = append(pairs, UserIDHeader, userID)
+	}
+
+	if len(pairs) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+func firstValue(ctx context.Context, key string) string {
+	values := metadata.ValueFromIncomingContext(ctx, key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// spanTraceID trace_id активного span OpenTelemetry
+func spanTraceID(ctx context.Context) (string, bool) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return "", false
+	}
+
+	return spanContext.TraceID().String(), true
+}
+
+// traceIDFromTraceparent достает trace-id из заголовка traceparent версии 00
+func traceIDFromTraceparent(traceparent string) (string, bool) {
+	parts := strings.Split(traceparent, "-")
+	if len(parts) != 4 || parts[0] != "00" || !isTraceID(parts[1]) {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
+// isTraceID проверяет формат trace-id W3C: 32 шестнадцатеричных символа в нижнем регистре, не все нули
+func isTraceID(id string) bool {
+	if len(id) != 32 || id == strings.Repeat("0", 32) {
+		return false
+	}
+
+	for _, r := range id {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newID случайный идентификатор из size байт в шестнадцатеричной записи
+func newID(size int) string {
+	b := make([]byte, size)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,147 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+)
+
+func TestUnaryServerTrace(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	tests := []struct {
+		name    string
+		md      metadata.MD
+		traceID string
+	}{
+		{
+			name:    "traceparent wins over request id",
+			md:      metadata.Pairs(TraceparentHeader, "00-"+traceID+"-00f067aa0ba902b7-01", RequestIDHeader, "req-1"),
+			traceID: traceID,
+		},
+		{
+			name:    "request id",
+			md:      metadata.Pairs(TraceparentHeader, "garbage", RequestIDHeader, "req-1"),
+			traceID: "req-1",
+		},
+		{
+			name: "generated",
+			md:   metadata.MD{},
+		},
+		{
+			name: "too long request id is replaced",
+			md:   metadata.Pairs(RequestIDHeader, strings.Repeat("r", maxRequestIDLength+1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.md.Set(UserIDHeader, "witness-42")
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			var gotTrace, gotUser string
+			_, err := UnaryServerTrace()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				gotTrace = logger.TraceIDFromContext(ctx)
+				gotUser = logger.UserIDFromContext(ctx)
+				return nil, nil
+			})
+			if err != nil {
+				t.Fatalf("interceptor: %v", err)
+			}
+
+			if tt.traceID != "" && gotTrace != tt.traceID {
+				t.Errorf("trace_id = %q, want %q", gotTrace, tt.traceID)
+			}
+			if tt.traceID == "" && !isTraceID(gotTrace) {
+				t.Errorf("generated trace_id %q is not a W3C trace id", gotTrace)
+			}
+			if gotUser != "witness-42" {
+				t.Errorf("user_id = %q, want witness-42", gotUser)
+			}
+		})
+	}
+}
+
+func TestUnaryClientTrace(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := logger.WithUserID(logger.WithTraceID(context.Background(), traceID), "witness-42")
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	if err := UnaryClientTrace()(ctx, "/ufo.v1.UFOService/Get", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("interceptor: %v", err)
+	}
+
+	if got := md.Get(RequestIDHeader); len(got) != 1 || got[0] != traceID {
+		t.Errorf("%s = %v, want %s", RequestIDHeader, got, traceID)
+	}
+	if got, ok := traceIDFromTraceparent(strings.Join(md.Get(TraceparentHeader), "")); !ok || got != traceID {
+		t.Errorf("%s = %v, want trace id %s", TraceparentHeader, md.Get(TraceparentHeader), traceID)
+	}
+	if got := md.Get(UserIDHeader); len(got) != 1 || got[0] != "witness-42" {
+		t.Errorf("%s = %v, want witness-42", UserIDHeader, got)
+	}
+
+	// Явно переданный вызывающим идентификатор запроса не перезаписывается
+	ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, "explicit")
+	if err := UnaryClientTrace()(ctx, "/ufo.v1.UFOService/Get", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("interceptor: %v", err)
+	}
+	if got := md.Get(RequestIDHeader); len(got) != 1 || got[0] != "explicit" {
+		t.Errorf("%s = %v, want explicit", RequestIDHeader, got)
+	}
+}
+
+func TestTraceFollowsActiveSpan(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	// Span otelgrpc важнее x-request-id клиента
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(RequestIDHeader, "req-1"))
+
+	var gotTrace string
+	_, err := UnaryServerTrace()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+		gotTrace = logger.TraceIDFromContext(ctx)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("interceptor: %v", err)
+	}
+	if gotTrace != traceID.String() {
+		t.Errorf("trace_id = %q, want %q", gotTrace, traceID)
+	}
+
+	// Исходящий traceparent продолжает активный span
+	var md metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	ctx = trace.ContextWithSpanContext(context.Background(), spanContext)
+	if err := UnaryClientTrace()(ctx, "/ufo.v1.UFOService/Get", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("interceptor: %v", err)
+	}
+
+	want := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	if got := md.Get(TraceparentHeader); len(got) != 1 || got[0] != want {
+		t.Errorf("%s = %v, want %s", TraceparentHeader, got, want)
+	}
+	if got := md.Get(RequestIDHeader); len(got) != 1 || got[0] != traceID.String() {
+		t.Errorf("%s = %v, want %s", RequestIDHeader, got, traceID)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -14,6 +15,7 @@ type Key string
 
 const (
 	traceIDKey Key = "trace_id"
+	spanIDKey  Key = "span_id"
 	userIDKey  Key = "user_id"
 )
 
@@ -85,6 +87,15 @@ func SetLevel(levelStr string) {
 	dynamicLevel.SetLevel(parseLevel(levelStr))
 }
 
+// Level возвращает текущий уровень логирования или пустую строку, если логгер не инициализирован через Init
+func Level() string {
+	if dynamicLevel == (zap.AtomicLevel{}) {
+		return ""
+	}
+
+	return dynamicLevel.Level().String()
+}
+
 func InitForBenchmark() {
 	core := zapcore.NewNopCore()
 
@@ -205,15 +216,43 @@ func parseLevel(levelStr string) zapcore.Level {
 	}
 }
 
-// fieldsFromContext вытаскивает enrich-поля из контекста
+// WithTraceID кладет в контекст идентификатор трассы: он добавляется полем trace_id ко всем записям с этим контекстом
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey, traceID)
+}
+
+// WithUserID кладет в контекст идентификатор пользователя: он добавляется полем user_id ко всем записям с этим контекстом
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// TraceIDFromContext возвращает идентификатор трассы из контекста или пустую строку
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDKey).(string)
+	return traceID
+}
+
+// UserIDFromContext возвращает идентификатор пользователя из контекста или пустую строку
+func UserIDFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(userIDKey).(string)
+	return userID
+}
+
+// fieldsFromContext вытаскивает enrich-поля из контекста. trace_id и span_id берутся из активного
+// span OpenTelemetry, а без него - trace_id, положенный через WithTraceID
 func fieldsFromContext(ctx context.Context) []zap.Field {
 	fields := make([]zap.Field, 0)
 
-	if traceID, ok := ctx.Value(traceIDKey).(string); ok && traceID != "" {
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields = append(fields,
+			zap.String(string(traceIDKey), spanContext.TraceID().String()),
+			zap.String(string(spanIDKey), spanContext.SpanID().String()),
+		)
+	} else if traceID := TraceIDFromContext(ctx); traceID != "" {
 		fields = append(fields, zap.String(string(traceIDKey), traceID))
 	}
 
-	if userID, ok := ctx.Value(userIDKey).(string); ok && userID != "" {
+	if userID := UserIDFromContext(ctx); userID != "" {
 		fields = append(fields, zap.String(string(userIDKey), userID))
 	}
 
@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const mongoTracerName = "github.com/baizhigit/go-ms-examples/di/platform/pkg/tracing/mongo"
+
+// spanKey команды различаются по RequestID только в пределах соединения
+type spanKey struct {
+	connectionID string
+	requestID    int64
+}
+
+type mongoMonitor struct {
+	tracer trace.Tracer
+	spans  sync.Map
+}
+
+// MongoMonitor создает span на каждую команду MongoDB; подключается через
+// options.Client().SetMonitor. Текст команды в span не попадает: в нем данные пользователей
+func MongoMonitor() *event.CommandMonitor {
+	return newMongoMonitor(otel.GetTracerProvider())
+}
+
+func newMongoMonitor(provider trace.TracerProvider) *event.CommandMonitor {
+	m := &mongoMonitor{tracer: provider.Tracer(mongoTracerName)}
+
+	return &event.CommandMonitor{
+		Started:   m.started,
+		Succeeded: m.succeeded,
+		Failed:    m.failed,
+	}
+}
+
+func (m *mongoMonitor) started(ctx context.Context, evt *event.CommandStartedEvent) {
+	attrs := []attribute.KeyValue{
+		semconv.DBSystemNameMongoDB,
+		semconv.DBOperationName(evt.CommandName),
+		semconv.DBNamespace(evt.DatabaseName),
+	}
+
+	name := evt.CommandName
+	// У команд над коллекцией (find, insert, update...) первый элемент - имя коллекции
+	if element, err := evt.Command.IndexErr(0); err == nil {
+		if collection, ok := element.Value().StringValueOK(); ok {
+			attrs = append(attrs, semconv.DBCollectionName(collection))
+			name = collection + "." + evt.CommandName
+		}
+	}
+
+	if host, port, err := net.SplitHostPort(serverAddress(evt.ConnectionID)); err == nil {
+		attrs = append(attrs, semconv.ServerAddress(host))
+		if p, err := strconv.Atoi(port); err == nil {
+			attrs = append(attrs, semconv.ServerPort(p))
+		}
+	}
+
+	_, span := m.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	m.spans.Store(spanKey{connectionID: evt.ConnectionID, requestID: evt.RequestID}, span)
+}
+
+func (m *mongoMonitor) succeeded(_ context.Context, evt *event.CommandSucceededEvent) {
+	m.finish(evt.CommandFinishedEvent, nil)
+}
+
+func (m *mongoMonitor) failed(_ context.Context, evt *event.CommandFailedEvent) {
+	m.finish(evt.CommandFinishedEvent, evt.Failure)
+}
+
+func (m *mongoMonitor) finish(evt event.CommandFinishedEvent, err error) {
+	value, ok := m.spans.LoadAndDelete(spanKey{connectionID: evt.ConnectionID, requestID: evt.RequestID})
+	if !ok {
+		return
+	}
+
+	span := value.(trace.Span)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// serverAddress host:port из ConnectionID драйвера вида "host:port[-N]"
+func serverAddress(connectionID string) string {
+	if i := strings.LastIndex(connectionID, "[-"); i >= 0 {
+		return connectionID[:i]
+	}
+
+	return connectionID
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+func TestMongoMonitor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	monitor := newMongoMonitor(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	ctx := context.Background()
+
+	find, err := bson.Marshal(bson.D{{Key: "find", Value: "sightings"}, {Key: "filter", Value: bson.D{}}})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	ping, err := bson.Marshal(bson.D{{Key: "ping", Value: 1}})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	// Одинаковые RequestID на разных соединениях - разные команды
+	monitor.Started(ctx, &event.CommandStartedEvent{
+		Command: find, DatabaseName: "ufo", CommandName: "find", RequestID: 1, ConnectionID: "mongo:27017[-1]",
+	})
+	monitor.Started(ctx, &event.CommandStartedEvent{
+		Command: ping, DatabaseName: "admin", CommandName: "ping", RequestID: 1, ConnectionID: "mongo:27017[-2]",
+	})
+	monitor.Failed(ctx, &event.CommandFailedEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "ping", RequestID: 1, ConnectionID: "mongo:27017[-2]"},
+		Failure:              errors.New("connection reset"),
+	})
+	monitor.Succeeded(ctx, &event.CommandSucceededEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "find", RequestID: 1, ConnectionID: "mongo:27017[-1]"},
+	})
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("ended spans = %d, want 2", len(spans))
+	}
+
+	ping0, find1 := spans[0], spans[1]
+	if ping0.Name() != "ping" || ping0.Status().Code != codes.Error {
+		t.Errorf("ping span = %q with status %v, want ping with error", ping0.Name(), ping0.Status())
+	}
+	if find1.Name() != "sightings.find" || find1.Status().Code == codes.Error {
+		t.Errorf("find span = %q with status %v, want sightings.find without error", find1.Name(), find1.Status())
+	}
+
+	attrs := make(map[string]string)
+	for _, attr := range find1.Attributes() {
+		attrs[string(attr.Key)] = attr.Value.Emit()
+	}
+	want := map[string]string{
+		string(semconv.DBCollectionNameKey): "sightings",
+		string(semconv.DBNamespaceKey):      "ufo",
+		string(semconv.ServerAddressKey):    "mongo",
+		string(semconv.ServerPortKey):       "27017",
+	}
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("attribute %s = %q, want %q", key, attrs[key], value)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Package tracing настраивает OpenTelemetry: провайдер трассировки с экспортером, выбранным
+// в конфигурации, W3C Trace Context для передачи трассы между сервисами и инструментацию
+// gRPC и MongoDB.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/stats"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/closer"
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+)
+
+// Поддерживаемые экспортеры
+const (
+	// ExporterOTLP отправляет spans коллектору по OTLP/gRPC
+	ExporterOTLP = "otlp"
+	// ExporterStdout пишет spans в JSON для локальной отладки: в stderr или в Options.StdoutFile.
+	// Не в stdout, чтобы spans не смешивались со структурированными логами
+	ExporterStdout = "stdout"
+	// ExporterNone не экспортирует spans: трасса из входящих запросов все равно передается дальше
+	// и попадает в логи
+	ExporterNone = "none"
+)
+
+type Options struct {
+	// ServiceName значение service.name в ресурсе провайдера
+	ServiceName string
+	// Exporter один из ExporterOTLP, ExporterStdout, ExporterNone
+	Exporter string
+	// OTLPEndpoint адрес коллектора host:port для ExporterOTLP
+	OTLPEndpoint string
+	// OTLPInsecure отключает TLS при подключении к коллектору
+	OTLPInsecure bool
+	// StdoutFile файл, в который ExporterStdout дописывает spans; пустой - stderr
+	StdoutFile string
+	// SampleRatio доля новых трасс, которые записываются: от 0 до 1. Решение вызывающего
+	// сервиса из traceparent соблюдается
+	SampleRatio float64
+}
+
+// Init устанавливает глобальные провайдер трассировки и propagator. Провайдер останавливается
+// в фазе closer.PhaseFlush, отправляя накопленные spans
+func Init(ctx context.Context, options Options) error {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	// Без экспортера остается no-op провайдер по умолчанию: spans не создаются,
+	// но контекст входящей трассы передается дальше
+	if options.Exporter == ExporterNone {
+		return nil
+	}
+
+	exporter, output, err := newExporter(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+		resource.WithAttributes(semconv.ServiceName(options.ServiceName)),
+	)
+	if err != nil {
+		return fmt.Errorf("tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logger.Warn(context.Background(), "opentelemetry error", zap.Error(err))
+	}))
+
+	// Файл spans закрывается после того, как провайдер отправит в него накопленное
+	closer.AddPhase(closer.PhaseFlush, "tracer provider", 0, func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if output != nil {
+			err = errors.Join(err, output.Close())
+		}
+		return err
+	})
+
+	return nil
+}
+
+// newExporter создает экспортер; output - открытый для него файл, который нужно закрыть после
+// остановки провайдера, или nil
+func newExporter(ctx context.Context, options Options) (exporter sdktrace.SpanExporter, output io.Closer, err error) {
+	switch options.Exporter {
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(options.OTLPEndpoint)}
+		if options.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+
+		// Подключение к коллектору ленивое: его недоступность не мешает запуску сервиса
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("otlp trace exporter: %w", err)
+		}
+
+		return exporter, nil, nil
+	case ExporterStdout:
+		var writer io.Writer = os.Stderr
+		if options.StdoutFile != "" {
+			file, err := os.OpenFile(options.StdoutFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+			if err != nil {
+				return nil, nil, fmt.Errorf("open trace file: %w", err)
+			}
+			writer, output = file, file
+		}
+
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(writer))
+		if err != nil {
+			if output != nil {
+				err = errors.Join(err, output.Close())
+			}
+			return nil, nil, fmt.Errorf("stdout trace exporter: %w", err)
+		}
+
+		return exporter, output, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter %q", options.Exporter)
+	}
+}
+
+// ServerHandler создает span на каждый входящий вызов; подключается через grpc.StatsHandler
+func ServerHandler() stats.Handler {
+	return otelgrpc.NewServerHandler()
+}
+
+// ClientHandler создает span на каждый исходящий вызов и передает трассу в traceparent;
+// подключается через grpc.WithStatsHandler
+func ClientHandler() stats.Handler {
+	return otelgrpc.NewClientHandler()
+}
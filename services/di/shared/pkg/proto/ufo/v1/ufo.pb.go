@@ -11,7 +11,9 @@ package ufov1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -26,6 +28,252 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// SightingEventType тип изменения наблюдения
+type SightingEventType int32
+
+const (
+	// SIGHTING_EVENT_TYPE_UNSPECIFIED тип не указан
+	SightingEventType_SIGHTING_EVENT_TYPE_UNSPECIFIED SightingEventType = 0
+	// SIGHTING_EVENT_TYPE_CREATED наблюдение создано
+	SightingEventType_SIGHTING_EVENT_TYPE_CREATED SightingEventType = 1
+	// SIGHTING_EVENT_TYPE_UPDATED наблюдение изменено
+	SightingEventType_SIGHTING_EVENT_TYPE_UPDATED SightingEventType = 2
+	// SIGHTING_EVENT_TYPE_DELETED наблюдение мягко удалено
+	SightingEventType_SIGHTING_EVENT_TYPE_DELETED SightingEventType = 3
+	// SIGHTING_EVENT_TYPE_RESTORED мягко удаленное наблюдение восстановлено
+	SightingEventType_SIGHTING_EVENT_TYPE_RESTORED SightingEventType = 4
+	// SIGHTING_EVENT_TYPE_PURGED наблюдение окончательно удалено
+	SightingEventType_SIGHTING_EVENT_TYPE_PURGED SightingEventType = 5
+)
+
+// Enum value maps for SightingEventType.
+var (
+	SightingEventType_name = map[int32]string{
+		0: "SIGHTING_EVENT_TYPE_UNSPECIFIED",
+		1: "SIGHTING_EVENT_TYPE_CREATED",
+		2: "SIGHTING_EVENT_TYPE_UPDATED",
+		3: "SIGHTING_EVENT_TYPE_DELETED",
+		4: "SIGHTING_EVENT_TYPE_RESTORED",
+		5: "SIGHTING_EVENT_TYPE_PURGED",
+	}
+	SightingEventType_value = map[string]int32{
+		"SIGHTING_EVENT_TYPE_UNSPECIFIED": 0,
+		"SIGHTING_EVENT_TYPE_CREATED":     1,
+		"SIGHTING_EVENT_TYPE_UPDATED":     2,
+		"SIGHTING_EVENT_TYPE_DELETED":     3,
+		"SIGHTING_EVENT_TYPE_RESTORED":    4,
+		"SIGHTING_EVENT_TYPE_PURGED":      5,
+	}
+)
+
+func (x SightingEventType) Enum() *SightingEventType {
+	p := new(SightingEventType)
+	*p = x
+	return p
+}
+
+func (x SightingEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SightingEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v1_ufo_proto_enumTypes[0].Descriptor()
+}
+
+func (SightingEventType) Type() protoreflect.EnumType {
+	return &file_ufo_v1_ufo_proto_enumTypes[0]
+}
+
+func (x SightingEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SightingEventType.Descriptor instead.
+func (SightingEventType) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{0}
+}
+
+// StatsGroupBy измерение, по которому группируется статистика
+type StatsGroupBy int32
+
+const (
+	// STATS_GROUP_BY_UNSPECIFIED измерение не указано
+	StatsGroupBy_STATS_GROUP_BY_UNSPECIFIED StatsGroupBy = 0
+	// STATS_GROUP_BY_DAY по дням наблюдения (UTC)
+	StatsGroupBy_STATS_GROUP_BY_DAY StatsGroupBy = 1
+	// STATS_GROUP_BY_WEEK по неделям наблюдения (ISO, с понедельника, UTC)
+	StatsGroupBy_STATS_GROUP_BY_WEEK StatsGroupBy = 2
+	// STATS_GROUP_BY_MONTH по месяцам наблюдения (UTC)
+	StatsGroupBy_STATS_GROUP_BY_MONTH StatsGroupBy = 3
+	// STATS_GROUP_BY_COLOR по цвету объекта
+	StatsGroupBy_STATS_GROUP_BY_COLOR StatsGroupBy = 4
+	// STATS_GROUP_BY_SOUND по наличию звука
+	StatsGroupBy_STATS_GROUP_BY_SOUND StatsGroupBy = 5
+)
+
+// Enum value maps for StatsGroupBy.
+var (
+	StatsGroupBy_name = map[int32]string{
+		0: "STATS_GROUP_BY_UNSPECIFIED",
+		1: "STATS_GROUP_BY_DAY",
+		2: "STATS_GROUP_BY_WEEK",
+		3: "STATS_GROUP_BY_MONTH",
+		4: "STATS_GROUP_BY_COLOR",
+		5: "STATS_GROUP_BY_SOUND",
+	}
+	StatsGroupBy_value = map[string]int32{
+		"STATS_GROUP_BY_UNSPECIFIED": 0,
+		"STATS_GROUP_BY_DAY":         1,
+		"STATS_GROUP_BY_WEEK":        2,
+		"STATS_GROUP_BY_MONTH":       3,
+		"STATS_GROUP_BY_COLOR":       4,
+		"STATS_GROUP_BY_SOUND":       5,
+	}
+)
+
+func (x StatsGroupBy) Enum() *StatsGroupBy {
+	p := new(StatsGroupBy)
+	*p = x
+	return p
+}
+
+func (x StatsGroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsGroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v1_ufo_proto_enumTypes[1].Descriptor()
+}
+
+func (StatsGroupBy) Type() protoreflect.EnumType {
+	return &file_ufo_v1_ufo_proto_enumTypes[1]
+}
+
+func (x StatsGroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsGroupBy.Descriptor instead.
+func (StatsGroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{1}
+}
+
+// RevisionAction операция, создавшая ревизию наблюдения
+type RevisionAction int32
+
+const (
+	// REVISION_ACTION_UNSPECIFIED операция не указана
+	RevisionAction_REVISION_ACTION_UNSPECIFIED RevisionAction = 0
+	// REVISION_ACTION_CREATED наблюдение создано
+	RevisionAction_REVISION_ACTION_CREATED RevisionAction = 1
+	// REVISION_ACTION_UPDATED поля наблюдения изменены
+	RevisionAction_REVISION_ACTION_UPDATED RevisionAction = 2
+	// REVISION_ACTION_DELETED наблюдение мягко удалено
+	RevisionAction_REVISION_ACTION_DELETED RevisionAction = 3
+	// REVISION_ACTION_RESTORED мягко удаленное наблюдение восстановлено
+	RevisionAction_REVISION_ACTION_RESTORED RevisionAction = 4
+)
+
+// Enum value maps for RevisionAction.
+var (
+	RevisionAction_name = map[int32]string{
+		0: "REVISION_ACTION_UNSPECIFIED",
+		1: "REVISION_ACTION_CREATED",
+		2: "REVISION_ACTION_UPDATED",
+		3: "REVISION_ACTION_DELETED",
+		4: "REVISION_ACTION_RESTORED",
+	}
+	RevisionAction_value = map[string]int32{
+		"REVISION_ACTION_UNSPECIFIED": 0,
+		"REVISION_ACTION_CREATED":     1,
+		"REVISION_ACTION_UPDATED":     2,
+		"REVISION_ACTION_DELETED":     3,
+		"REVISION_ACTION_RESTORED":    4,
+	}
+)
+
+func (x RevisionAction) Enum() *RevisionAction {
+	p := new(RevisionAction)
+	*p = x
+	return p
+}
+
+func (x RevisionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevisionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v1_ufo_proto_enumTypes[2].Descriptor()
+}
+
+func (RevisionAction) Type() protoreflect.EnumType {
+	return &file_ufo_v1_ufo_proto_enumTypes[2]
+}
+
+func (x RevisionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevisionAction.Descriptor instead.
+func (RevisionAction) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{2}
+}
+
+// GeoPoint точка на поверхности Земли в градусах (WGS 84)
+type GeoPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// latitude широта от -90 до 90
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// longitude долгота от -180 до 180
+	Longitude     float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
 // SightingInfo базовая информация о наблюдении НЛО
 type SightingInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -41,13 +289,15 @@ type SightingInfo struct {
 	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
 	// duration_seconds продолжительность наблюдения в секундах (опционально)
 	DurationSeconds *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// coordinates координаты места наблюдения (опционально), по ним работает FindNearby
+	Coordinates   *GeoPoint `protobuf:"bytes,7,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SightingInfo) Reset() {
 	*x = SightingInfo{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[0]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -59,7 +309,7 @@ func (x *SightingInfo) String() string {
 func (*SightingInfo) ProtoMessage() {}
 
 func (x *SightingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[0]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -72,7 +322,7 @@ func (x *SightingInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SightingInfo.ProtoReflect.Descriptor instead.
 func (*SightingInfo) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{0}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *SightingInfo) GetObservedAt() *timestamppb.Timestamp {
@@ -117,6 +367,13 @@ func (x *SightingInfo) GetDurationSeconds() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *SightingInfo) GetCoordinates() *GeoPoint {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
 // SightingUpdateInfo информация о наблюдении НЛО для обновления (все поля опциональны)
 type SightingUpdateInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -132,13 +389,15 @@ type SightingUpdateInfo struct {
 	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
 	// duration_seconds продолжительность наблюдения в секундах (опционально)
 	DurationSeconds *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// coordinates координаты места наблюдения (опционально)
+	Coordinates   *GeoPoint `protobuf:"bytes,7,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SightingUpdateInfo) Reset() {
 	*x = SightingUpdateInfo{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[1]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -150,7 +409,7 @@ func (x *SightingUpdateInfo) String() string {
 func (*SightingUpdateInfo) ProtoMessage() {}
 
 func (x *SightingUpdateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[1]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -163,7 +422,7 @@ func (x *SightingUpdateInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SightingUpdateInfo.ProtoReflect.Descriptor instead.
 func (*SightingUpdateInfo) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{1}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SightingUpdateInfo) GetObservedAt() *timestamppb.Timestamp {
@@ -208,6 +467,13 @@ func (x *SightingUpdateInfo) GetDurationSeconds() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *SightingUpdateInfo) GetCoordinates() *GeoPoint {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
 // Sighting представляет полную информацию о наблюдении НЛО
 type Sighting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -220,14 +486,18 @@ type Sighting struct {
 	// updated_at время последнего обновления записи
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// deleted_at время удаления записи (опционально)
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// version версия записи, увеличивается при каждом изменении
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// attachments вложения наблюдения в порядке загрузки
+	Attachments   []*Attachment `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Sighting) Reset() {
 	*x = Sighting{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[2]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +509,7 @@ func (x *Sighting) String() string {
 func (*Sighting) ProtoMessage() {}
 
 func (x *Sighting) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[2]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +522,7 @@ func (x *Sighting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sighting.ProtoReflect.Descriptor instead.
 func (*Sighting) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{2}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Sighting) GetUuid() string {
@@ -290,6 +560,111 @@ func (x *Sighting) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Sighting) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Sighting) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Attachment метаданные вложения наблюдения
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id идентификатор вложения
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// file_name имя файла, переданное при загрузке
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// content_type тип содержимого, определенный по первым байтам файла
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// size_bytes размер содержимого в байтах
+	SizeBytes int64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// sha256 контрольная сумма содержимого SHA-256 в шестнадцатеричном виде
+	Sha256 string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// created_at время загрузки
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 // CreateRequest запрос на создание наблюдения НЛО
 type CreateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -301,7 +676,7 @@ type CreateRequest struct {
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +688,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +701,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{3}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateRequest) GetInfo() *SightingInfo {
@@ -340,14 +715,17 @@ func (x *CreateRequest) GetInfo() *SightingInfo {
 type CreateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// uuid идентификатор созданного наблюдения
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// possible_duplicates ранее созданные наблюдения того же события: то же место, близкое время
+	// и похожее описание. Их можно объединить с новым наблюдением через MergeSightings
+	PossibleDuplicates []*Sighting `protobuf:"bytes,2,rep,name=possible_duplicates,json=possibleDuplicates,proto3" json:"possible_duplicates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +737,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +750,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{4}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateResponse) GetUuid() string {
@@ -382,6 +760,13 @@ func (x *CreateResponse) GetUuid() string {
 	return ""
 }
 
+func (x *CreateResponse) GetPossibleDuplicates() []*Sighting {
+	if x != nil {
+		return x.PossibleDuplicates
+	}
+	return nil
+}
+
 // GetRequest запрос на получение наблюдения по идентификатору
 type GetRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -393,7 +778,7 @@ type GetRequest struct {
 
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +790,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +803,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{5}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetRequest) GetUuid() string {
@@ -439,7 +824,7 @@ type GetResponse struct {
 
 func (x *GetResponse) Reset() {
 	*x = GetResponse{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -451,7 +836,7 @@ func (x *GetResponse) String() string {
 func (*GetResponse) ProtoMessage() {}
 
 func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +849,7 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{6}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetResponse) GetSighting() *Sighting {
@@ -480,14 +865,20 @@ type UpdateRequest struct {
 	// uuid идентификатор наблюдения для обновления
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	// Обновляемая информация о наблюдении (частичное обновление)
-	UpdateInfo    *SightingUpdateInfo `protobuf:"bytes,2,opt,name=update_info,json=updateInfo,proto3" json:"update_info,omitempty"`
+	UpdateInfo *SightingUpdateInfo `protobuf:"bytes,2,opt,name=update_info,json=updateInfo,proto3" json:"update_info,omitempty"`
+	// expected_version ожидаемая текущая версия записи (опционально, при несовпадении возвращается ABORTED)
+	ExpectedVersion *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// update_mask поля update_info, которые нужно изменить (опционально). Поле из маски, не заданное
+	// в update_info, очищается; поля update_info вне маски игнорируются. Без маски меняются только
+	// заданные поля update_info
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
 	*x = UpdateRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +890,7 @@ func (x *UpdateRequest) String() string {
 func (*UpdateRequest) ProtoMessage() {}
 
 func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +903,7 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{7}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateRequest) GetUuid() string {
@@ -529,30 +920,1991 @@ func (x *UpdateRequest) GetUpdateInfo() *SightingUpdateInfo {
 	return nil
 }
 
-// DeleteRequest запрос на удаление наблюдения
-type DeleteRequest struct {
+func (x *UpdateRequest) GetExpectedVersion() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateResponse ответ с обновленным наблюдением
+type UpdateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// uuid идентификатор наблюдения для удаления
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// sighting данные наблюдения после обновления
+	Sighting      *Sighting `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateResponse) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+// DeleteRequest запрос на удаление наблюдения
+type DeleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор наблюдения для удаления
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// expected_version ожидаемая текущая версия записи (опционально, при несовпадении возвращается ABORTED)
+	ExpectedVersion *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DeleteRequest) GetExpectedVersion() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+// SightingFilter фильтр для выборки наблюдений (все поля опциональны)
+type SightingFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// observed_from нижняя граница времени наблюдения (включительно)
+	ObservedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_from,json=observedFrom,proto3" json:"observed_from,omitempty"`
+	// observed_to верхняя граница времени наблюдения (не включительно)
+	ObservedTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=observed_to,json=observedTo,proto3" json:"observed_to,omitempty"`
+	// location подстрока места наблюдения (без учета регистра)
+	Location *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// color точное совпадение цвета объекта
+	Color *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	// sound признак наличия звука
+	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
+	// include_deleted включать ли в выборку мягко удаленные наблюдения
+	IncludeDeleted bool `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SightingFilter) Reset() {
+	*x = SightingFilter{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SightingFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SightingFilter) ProtoMessage() {}
+
+func (x *SightingFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SightingFilter.ProtoReflect.Descriptor instead.
+func (*SightingFilter) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SightingFilter) GetObservedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedFrom
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetObservedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedTo
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetColor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetSound() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Sound
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// ListRequest запрос на получение списка наблюдений
+type ListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter условия выборки
+	Filter *SightingFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size максимальное количество наблюдений на странице
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token курсор, полученный из предыдущего ответа (пустой для первой страницы)
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListRequest) GetFilter() *SightingFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListResponse страница наблюдений
+type ListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sightings наблюдения, отсортированные по убыванию времени наблюдения
+	Sightings []*Sighting `protobuf:"bytes,1,rep,name=sightings,proto3" json:"sightings,omitempty"`
+	// next_page_token курсор следующей страницы (пустой, если страниц больше нет)
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListResponse) GetSightings() []*Sighting {
+	if x != nil {
+		return x.Sightings
+	}
+	return nil
+}
+
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RestoreRequest запрос на восстановление мягко удаленного наблюдения
+type RestoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор наблюдения для восстановления
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// PurgeRequest запрос на окончательное удаление наблюдений
+type PurgeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// older_than_days удаляются наблюдения, мягко удаленные более указанного количества дней назад
+	OlderThanDays int32 `protobuf:"varint,1,opt,name=older_than_days,json=olderThanDays,proto3" json:"older_than_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeRequest) Reset() {
+	*x = PurgeRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRequest) ProtoMessage() {}
+
+func (x *PurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRequest.ProtoReflect.Descriptor instead.
+func (*PurgeRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PurgeRequest) GetOlderThanDays() int32 {
+	if x != nil {
+		return x.OlderThanDays
+	}
+	return 0
+}
+
+// PurgeResponse результат окончательного удаления
+type PurgeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// purged_count количество удаленных наблюдений
+	PurgedCount   int64 `protobuf:"varint,1,opt,name=purged_count,json=purgedCount,proto3" json:"purged_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeResponse) Reset() {
+	*x = PurgeResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeResponse) ProtoMessage() {}
+
+func (x *PurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeResponse.ProtoReflect.Descriptor instead.
+func (*PurgeResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PurgeResponse) GetPurgedCount() int64 {
+	if x != nil {
+		return x.PurgedCount
+	}
+	return 0
+}
+
+// WatchSightingsRequest запрос на подписку на события наблюдений
+type WatchSightingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resume_token токен последнего полученного события (пустой - только новые события)
+	ResumeToken   string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSightingsRequest) Reset() {
+	*x = WatchSightingsRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSightingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSightingsRequest) ProtoMessage() {}
+
+func (x *WatchSightingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSightingsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSightingsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchSightingsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// SightingEvent событие изменения наблюдения
+type SightingEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type тип изменения
+	Type SightingEventType `protobuf:"varint,1,opt,name=type,proto3,enum=ufo.v1.SightingEventType" json:"type,omitempty"`
+	// uuid идентификатор измененного наблюдения
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// sighting состояние наблюдения после изменения (не заполняется для PURGED)
+	Sighting *Sighting `protobuf:"bytes,3,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	// occurred_at время изменения
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// resume_token токен для продолжения подписки сразу после этого события
+	ResumeToken   string `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SightingEvent) Reset() {
+	*x = SightingEvent{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SightingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SightingEvent) ProtoMessage() {}
+
+func (x *SightingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SightingEvent.ProtoReflect.Descriptor instead.
+func (*SightingEvent) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SightingEvent) GetType() SightingEventType {
+	if x != nil {
+		return x.Type
+	}
+	return SightingEventType_SIGHTING_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *SightingEvent) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SightingEvent) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+func (x *SightingEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *SightingEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// BatchCreateRequest запрос на создание нескольких наблюдений
+type BatchCreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// infos данные создаваемых наблюдений (не больше максимального размера пакета)
+	Infos         []*SightingInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateRequest) Reset() {
+	*x = BatchCreateRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateRequest) ProtoMessage() {}
+
+func (x *BatchCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchCreateRequest) GetInfos() []*SightingInfo {
+	if x != nil {
+		return x.Infos
+	}
+	return nil
+}
+
+// BatchCreateResult результат создания одного наблюдения из пакета
+type BatchCreateResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*BatchCreateResult_Uuid
+	//	*BatchCreateResult_Error
+	Result        isBatchCreateResult_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateResult) Reset() {
+	*x = BatchCreateResult{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateResult) ProtoMessage() {}
+
+func (x *BatchCreateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateResult.ProtoReflect.Descriptor instead.
+func (*BatchCreateResult) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchCreateResult) GetResult() isBatchCreateResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *BatchCreateResult) GetUuid() string {
+	if x != nil {
+		if x, ok := x.Result.(*BatchCreateResult_Uuid); ok {
+			return x.Uuid
+		}
+	}
+	return ""
+}
+
+func (x *BatchCreateResult) GetError() string {
+	if x != nil {
+		if x, ok := x.Result.(*BatchCreateResult_Error); ok {
+			return x.Error
+		}
+	}
+	return ""
+}
+
+type isBatchCreateResult_Result interface {
+	isBatchCreateResult_Result()
+}
+
+type BatchCreateResult_Uuid struct {
+	// uuid идентификатор созданного наблюдения
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3,oneof"`
+}
+
+type BatchCreateResult_Error struct {
+	// error причина, по которой наблюдение не создано
+	Error string `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchCreateResult_Uuid) isBatchCreateResult_Result() {}
+
+func (*BatchCreateResult_Error) isBatchCreateResult_Result() {}
+
+// BatchCreateResponse результаты создания в том же порядке, что и infos в запросе
+type BatchCreateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results результат для каждого элемента запроса
+	Results       []*BatchCreateResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateResponse) Reset() {
+	*x = BatchCreateResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateResponse) ProtoMessage() {}
+
+func (x *BatchCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchCreateResponse) GetResults() []*BatchCreateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchGetRequest запрос на получение нескольких наблюдений
+type BatchGetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuids идентификаторы наблюдений (не больше максимального размера пакета)
+	Uuids         []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetRequest) Reset() {
+	*x = BatchGetRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRequest) ProtoMessage() {}
+
+func (x *BatchGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchGetRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+// BatchGetResponse найденные наблюдения
+type BatchGetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sightings найденные наблюдения в порядке uuids из запроса (повторы исключаются)
+	Sightings []*Sighting `protobuf:"bytes,1,rep,name=sightings,proto3" json:"sightings,omitempty"`
+	// missing_uuids идентификаторы, для которых наблюдение не найдено или мягко удалено
+	MissingUuids  []string `protobuf:"bytes,2,rep,name=missing_uuids,json=missingUuids,proto3" json:"missing_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetResponse) Reset() {
+	*x = BatchGetResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetResponse) ProtoMessage() {}
+
+func (x *BatchGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchGetResponse) GetSightings() []*Sighting {
+	if x != nil {
+		return x.Sightings
+	}
+	return nil
+}
+
+func (x *BatchGetResponse) GetMissingUuids() []string {
+	if x != nil {
+		return x.MissingUuids
+	}
+	return nil
+}
+
+// ImportSightingsRequest одно наблюдение из импортируемого потока
+type ImportSightingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// info данные импортируемого наблюдения
+	Info          *SightingInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSightingsRequest) Reset() {
+	*x = ImportSightingsRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSightingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSightingsRequest) ProtoMessage() {}
+
+func (x *ImportSightingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSightingsRequest.ProtoReflect.Descriptor instead.
+func (*ImportSightingsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportSightingsRequest) GetInfo() *SightingInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// ImportRejection наблюдение из потока, которое не удалось сохранить
+type ImportRejection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index порядковый номер сообщения в потоке, начиная с 0
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// reason причина отказа
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRejection) Reset() {
+	*x = ImportRejection{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRejection) ProtoMessage() {}
+
+func (x *ImportRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRejection.ProtoReflect.Descriptor instead.
+func (*ImportRejection) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportRejection) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportRejection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ImportSightingsResponse итог импорта
+type ImportSightingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// received_count количество принятых сообщений
+	ReceivedCount int64 `protobuf:"varint,1,opt,name=received_count,json=receivedCount,proto3" json:"received_count,omitempty"`
+	// inserted_count количество сохраненных наблюдений
+	InsertedCount int64 `protobuf:"varint,2,opt,name=inserted_count,json=insertedCount,proto3" json:"inserted_count,omitempty"`
+	// rejected_count количество отклоненных наблюдений
+	RejectedCount int64 `protobuf:"varint,3,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	// rejections причины отказов (не больше первой тысячи, полное количество в rejected_count)
+	Rejections []*ImportRejection `protobuf:"bytes,4,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	// duration длительность импорта на стороне сервера
+	Duration      *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSightingsResponse) Reset() {
+	*x = ImportSightingsResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSightingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSightingsResponse) ProtoMessage() {}
+
+func (x *ImportSightingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSightingsResponse.ProtoReflect.Descriptor instead.
+func (*ImportSightingsResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportSightingsResponse) GetReceivedCount() int64 {
+	if x != nil {
+		return x.ReceivedCount
+	}
+	return 0
+}
+
+func (x *ImportSightingsResponse) GetInsertedCount() int64 {
+	if x != nil {
+		return x.InsertedCount
+	}
+	return 0
+}
+
+func (x *ImportSightingsResponse) GetRejectedCount() int64 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *ImportSightingsResponse) GetRejections() []*ImportRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *ImportSightingsResponse) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// SearchRequest запрос полнотекстового поиска
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query слова для поиска, наблюдение подходит, если содержит любое из них (регистр не учитывается)
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit максимальное количество результатов
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchHighlight фрагмент поля, в котором найденные слова обернуты в <em>...</em>
+type SearchHighlight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field имя поля: location или description
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// fragment текст поля (для длинного поля - фрагмент вокруг первого совпадения)
+	Fragment      string `protobuf:"bytes,2,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetFragment() string {
+	if x != nil {
+		return x.Fragment
+	}
+	return ""
+}
+
+// SearchHit найденное наблюдение
+type SearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting данные наблюдения
+	Sighting *Sighting `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	// score релевантность; сравнима только внутри одного ответа
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// highlights подсвеченные совпадения по полям
+	Highlights    []*SearchHighlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SearchHit) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// SearchResponse результаты поиска в порядке убывания релевантности
+type SearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hits найденные наблюдения (мягко удаленные не ищутся)
+	Hits          []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// FindNearbyRequest запрос наблюдений рядом с точкой
+type FindNearbyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// center точка, от которой считается расстояние
+	Center *GeoPoint `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	// radius_meters радиус поиска в метрах
+	RadiusMeters float64 `protobuf:"fixed64,2,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	// limit максимальное количество наблюдений
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearbyRequest) Reset() {
+	*x = FindNearbyRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearbyRequest) ProtoMessage() {}
+
+func (x *FindNearbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearbyRequest.ProtoReflect.Descriptor instead.
+func (*FindNearbyRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FindNearbyRequest) GetCenter() *GeoPoint {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *FindNearbyRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *FindNearbyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// NearbySighting наблюдение вместе с расстоянием до центра поиска
+type NearbySighting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting данные наблюдения
+	Sighting *Sighting `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	// distance_meters расстояние от центра поиска в метрах
+	DistanceMeters float64 `protobuf:"fixed64,2,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NearbySighting) Reset() {
+	*x = NearbySighting{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbySighting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbySighting) ProtoMessage() {}
+
+func (x *NearbySighting) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbySighting.ProtoReflect.Descriptor instead.
+func (*NearbySighting) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *NearbySighting) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+func (x *NearbySighting) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+// FindNearbyResponse наблюдения в порядке возрастания расстояния
+type FindNearbyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sightings найденные наблюдения (без координат и мягко удаленные не возвращаются)
+	Sightings     []*NearbySighting `protobuf:"bytes,1,rep,name=sightings,proto3" json:"sightings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearbyResponse) Reset() {
+	*x = FindNearbyResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearbyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearbyResponse) ProtoMessage() {}
+
+func (x *FindNearbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearbyResponse.ProtoReflect.Descriptor instead.
+func (*FindNearbyResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FindNearbyResponse) GetSightings() []*NearbySighting {
+	if x != nil {
+		return x.Sightings
+	}
+	return nil
+}
+
+// GetStatsRequest запрос статистики наблюдений
+type GetStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// observed_from начало периода по времени наблюдения, включительно
+	ObservedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_from,json=observedFrom,proto3" json:"observed_from,omitempty"`
+	// observed_to конец периода по времени наблюдения, не включительно
+	ObservedTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=observed_to,json=observedTo,proto3" json:"observed_to,omitempty"`
+	// group_by измерение группировки
+	GroupBy       StatsGroupBy `protobuf:"varint,3,opt,name=group_by,json=groupBy,proto3,enum=ufo.v1.StatsGroupBy" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetStatsRequest) GetObservedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedFrom
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetObservedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedTo
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetGroupBy() StatsGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return StatsGroupBy_STATS_GROUP_BY_UNSPECIFIED
+}
+
+// StatsBucket количество наблюдений в одной группе
+type StatsBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key подпись группы: 2024-06-15, 2024-W24, 2024-06, цвет или true/false;
+	// пустая строка для наблюдений без цвета или без признака звука
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// start начало интервала для группировки по времени
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// count количество наблюдений
+	Count         int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsBucket) Reset() {
+	*x = StatsBucket{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsBucket) ProtoMessage() {}
+
+func (x *StatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsBucket.ProtoReflect.Descriptor instead.
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StatsBucket) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatsBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *StatsBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetStatsResponse статистика наблюдений (мягко удаленные не учитываются)
+type GetStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// group_by измерение группировки
+	GroupBy StatsGroupBy `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=ufo.v1.StatsGroupBy" json:"group_by,omitempty"`
+	// buckets группы; по времени - непрерывный ряд по возрастанию с нулями в пустых интервалах,
+	// по цвету и звуку - по убыванию количества
+	Buckets []*StatsBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// total количество наблюдений во всех группах
+	Total         int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetStatsResponse) GetGroupBy() StatsGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return StatsGroupBy_STATS_GROUP_BY_UNSPECIFIED
+}
+
+func (x *GetStatsResponse) GetBuckets() []*StatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// UploadAttachmentMetadata описание загружаемого вложения
+type UploadAttachmentMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting_uuid наблюдение, к которому относится вложение
+	SightingUuid string `protobuf:"bytes,1,opt,name=sighting_uuid,json=sightingUuid,proto3" json:"sighting_uuid,omitempty"`
+	// file_name имя файла
+	FileName      string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentMetadata) Reset() {
+	*x = UploadAttachmentMetadata{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentMetadata) ProtoMessage() {}
+
+func (x *UploadAttachmentMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentMetadata.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentMetadata) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UploadAttachmentMetadata) GetSightingUuid() string {
+	if x != nil {
+		return x.SightingUuid
+	}
+	return ""
+}
+
+func (x *UploadAttachmentMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// UploadAttachmentRequest одно сообщение потока загрузки вложения
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Metadata
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetMetadata() *UploadAttachmentMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Metadata struct {
+	// metadata описание вложения, только в первом сообщении
+	Metadata *UploadAttachmentMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	// chunk очередная часть содержимого файла
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Metadata) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+// UploadAttachmentResponse результат загрузки вложения
+type UploadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attachment метаданные сохраненного вложения
+	Attachment    *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// DownloadAttachmentRequest запрос вложения наблюдения
+type DownloadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting_uuid наблюдение, к которому относится вложение
+	SightingUuid string `protobuf:"bytes,1,opt,name=sighting_uuid,json=sightingUuid,proto3" json:"sighting_uuid,omitempty"`
+	// attachment_id идентификатор вложения
+	AttachmentId  string `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DownloadAttachmentRequest) GetSightingUuid() string {
+	if x != nil {
+		return x.SightingUuid
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+// DownloadAttachmentResponse одно сообщение потока выгрузки вложения
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	// attachment метаданные вложения, только в первом сообщении
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	// chunk очередная часть содержимого файла
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+// FieldChange изменение одного поля наблюдения
+type FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field имя поля SightingInfo
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// old_value значение до изменения в текстовом виде (не задано, если поле было пустым)
+	OldValue *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value значение после изменения в текстовом виде (не задано, если поле стало пустым)
+	NewValue      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRequest) String() string {
+func (x *FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+func (*FieldChange) ProtoMessage() {}
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,23 +2915,360 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DeleteRequest) GetUuid() string {
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *FieldChange) GetNewValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+// SightingRevision неизменяемая запись об одном изменении наблюдения
+type SightingRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting_uuid идентификатор наблюдения
+	SightingUuid string `protobuf:"bytes,1,opt,name=sighting_uuid,json=sightingUuid,proto3" json:"sighting_uuid,omitempty"`
+	// version версия наблюдения после изменения
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// action операция, создавшая ревизию
+	Action RevisionAction `protobuf:"varint,3,opt,name=action,proto3,enum=ufo.v1.RevisionAction" json:"action,omitempty"`
+	// actor кто внес изменение (метаданные x-user-id запроса), пустой, если неизвестно
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// occurred_at время изменения
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// changes изменившиеся поля; для создания - все заполненные поля, для удаления и восстановления - пусто
+	Changes []*FieldChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	// sighting состояние наблюдения после изменения (вложения - только метаданные)
+	Sighting      *Sighting `protobuf:"bytes,7,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SightingRevision) Reset() {
+	*x = SightingRevision{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SightingRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SightingRevision) ProtoMessage() {}
+
+func (x *SightingRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SightingRevision.ProtoReflect.Descriptor instead.
+func (*SightingRevision) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SightingRevision) GetSightingUuid() string {
+	if x != nil {
+		return x.SightingUuid
+	}
+	return ""
+}
+
+func (x *SightingRevision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SightingRevision) GetAction() RevisionAction {
+	if x != nil {
+		return x.Action
+	}
+	return RevisionAction_REVISION_ACTION_UNSPECIFIED
+}
+
+func (x *SightingRevision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SightingRevision) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *SightingRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SightingRevision) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+// GetHistoryRequest запрос истории изменений наблюдения
+type GetHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор наблюдения
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// as_of момент времени, на который нужно восстановить наблюдение (опционально)
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetHistoryRequest) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
+func (x *GetHistoryRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// GetHistoryResponse история изменений наблюдения
+type GetHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revisions ревизии по возрастанию версии
+	Revisions []*SightingRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// as_of_sighting состояние наблюдения на момент as_of, заполняется только если as_of задан
+	AsOfSighting  *Sighting `protobuf:"bytes,2,opt,name=as_of_sighting,json=asOfSighting,proto3" json:"as_of_sighting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetHistoryResponse) GetRevisions() []*SightingRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *GetHistoryResponse) GetAsOfSighting() *Sighting {
+	if x != nil {
+		return x.AsOfSighting
+	}
+	return nil
+}
+
+// MergeSightingsRequest запрос на объединение дубликатов с основным наблюдением
+type MergeSightingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// target_uuid идентификатор основного наблюдения, которое остается после объединения
+	TargetUuid string `protobuf:"bytes,1,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	// duplicate_uuids идентификаторы дубликатов, которые будут мягко удалены
+	DuplicateUuids []string `protobuf:"bytes,2,rep,name=duplicate_uuids,json=duplicateUuids,proto3" json:"duplicate_uuids,omitempty"`
+	// expected_version ожидаемая текущая версия основного наблюдения (опционально, при несовпадении возвращается ABORTED)
+	ExpectedVersion *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeSightingsRequest) Reset() {
+	*x = MergeSightingsRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeSightingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeSightingsRequest) ProtoMessage() {}
+
+func (x *MergeSightingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeSightingsRequest.ProtoReflect.Descriptor instead.
+func (*MergeSightingsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MergeSightingsRequest) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+func (x *MergeSightingsRequest) GetDuplicateUuids() []string {
+	if x != nil {
+		return x.DuplicateUuids
+	}
+	return nil
+}
+
+func (x *MergeSightingsRequest) GetExpectedVersion() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+// MergeSightingsResponse результат объединения
+type MergeSightingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting основное наблюдение после объединения
+	Sighting      *Sighting `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeSightingsResponse) Reset() {
+	*x = MergeSightingsResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeSightingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeSightingsResponse) ProtoMessage() {}
+
+func (x *MergeSightingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeSightingsResponse.ProtoReflect.Descriptor instead.
+func (*MergeSightingsResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MergeSightingsResponse) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
 var File_ufo_v1_ufo_proto protoreflect.FileDescriptor
 
 const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\n" +
-	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xb7\x02\n" +
+	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1egoogle/protobuf/duration.proto\"D\n" +
+	"\bGeoPoint\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xeb\x02\n" +
 	"\fSightingInfo\x12;\n" +
 	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"observedAt\x12\x1a\n" +
@@ -587,7 +3276,8 @@ const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
 	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
 	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x02\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\x122\n" +
+	"\vcoordinates\x18\a \x01(\v2\x10.ufo.v1.GeoPointR\vcoordinates\"\xad\x03\n" +
 	"\x12SightingUpdateInfo\x12;\n" +
 	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"observedAt\x128\n" +
@@ -595,7 +3285,8 @@ const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x122\n" +
 	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
 	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x01\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\x122\n" +
+	"\vcoordinates\x18\a \x01(\v2\x10.ufo.v1.GeoPointR\vcoordinates\"\xc9\x02\n" +
 	"\bSighting\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12(\n" +
 	"\x04info\x18\x02 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\x129\n" +
@@ -604,28 +3295,222 @@ const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
 	"\n" +
-	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"9\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x124\n" +
+	"\vattachments\x18\a \x03(\v2\x12.ufo.v1.AttachmentR\vattachments\"\xce\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"9\n" +
 	"\rCreateRequest\x12(\n" +
-	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\"$\n" +
+	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\"g\n" +
 	"\x0eCreateResponse\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\" \n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12A\n" +
+	"\x13possible_duplicates\x18\x02 \x03(\v2\x10.ufo.v1.SightingR\x12possibleDuplicates\" \n" +
 	"\n" +
 	"GetRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
 	"\vGetResponse\x12,\n" +
-	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"`\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"\xe5\x01\n" +
 	"\rUpdateRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12;\n" +
 	"\vupdate_info\x18\x02 \x01(\v2\x1a.ufo.v1.SightingUpdateInfoR\n" +
-	"updateInfo\"#\n" +
+	"updateInfo\x12F\n" +
+	"\x10expected_version\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\x0fexpectedVersion\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\">\n" +
+	"\x0eUpdateResponse\x12,\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"k\n" +
 	"\rDeleteRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid2\xe7\x01\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12F\n" +
+	"\x10expected_version\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x0fexpectedVersion\"\xd7\x02\n" +
+	"\x0eSightingFilter\x12?\n" +
+	"\robserved_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fobservedFrom\x12;\n" +
+	"\vobserved_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedTo\x128\n" +
+	"\blocation\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x122\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\"y\n" +
+	"\vListRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.ufo.v1.SightingFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"f\n" +
+	"\fListResponse\x12.\n" +
+	"\tsightings\x18\x01 \x03(\v2\x10.ufo.v1.SightingR\tsightings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"$\n" +
+	"\x0eRestoreRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"6\n" +
+	"\fPurgeRequest\x12&\n" +
+	"\x0folder_than_days\x18\x01 \x01(\x05R\rolderThanDays\"2\n" +
+	"\rPurgeResponse\x12!\n" +
+	"\fpurged_count\x18\x01 \x01(\x03R\vpurgedCount\":\n" +
+	"\x15WatchSightingsRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\"\xe0\x01\n" +
+	"\rSightingEvent\x12-\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x19.ufo.v1.SightingEventTypeR\x04type\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12,\n" +
+	"\bsighting\x18\x03 \x01(\v2\x10.ufo.v1.SightingR\bsighting\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"@\n" +
+	"\x12BatchCreateRequest\x12*\n" +
+	"\x05infos\x18\x01 \x03(\v2\x14.ufo.v1.SightingInfoR\x05infos\"K\n" +
+	"\x11BatchCreateResult\x12\x14\n" +
+	"\x04uuid\x18\x01 \x01(\tH\x00R\x04uuid\x12\x16\n" +
+	"\x05error\x18\x02 \x01(\tH\x00R\x05errorB\b\n" +
+	"\x06result\"J\n" +
+	"\x13BatchCreateResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.ufo.v1.BatchCreateResultR\aresults\"'\n" +
+	"\x0fBatchGetRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\"g\n" +
+	"\x10BatchGetResponse\x12.\n" +
+	"\tsightings\x18\x01 \x03(\v2\x10.ufo.v1.SightingR\tsightings\x12#\n" +
+	"\rmissing_uuids\x18\x02 \x03(\tR\fmissingUuids\"B\n" +
+	"\x16ImportSightingsRequest\x12(\n" +
+	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\"?\n" +
+	"\x0fImportRejection\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xfe\x01\n" +
+	"\x17ImportSightingsResponse\x12%\n" +
+	"\x0ereceived_count\x18\x01 \x01(\x03R\rreceivedCount\x12%\n" +
+	"\x0einserted_count\x18\x02 \x01(\x03R\rinsertedCount\x12%\n" +
+	"\x0erejected_count\x18\x03 \x01(\x03R\rrejectedCount\x127\n" +
+	"\n" +
+	"rejections\x18\x04 \x03(\v2\x17.ufo.v1.ImportRejectionR\n" +
+	"rejections\x125\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bduration\";\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"C\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\bfragment\x18\x02 \x01(\tR\bfragment\"\x88\x01\n" +
+	"\tSearchHit\x12,\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x127\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x17.ufo.v1.SearchHighlightR\n" +
+	"highlights\"7\n" +
+	"\x0eSearchResponse\x12%\n" +
+	"\x04hits\x18\x01 \x03(\v2\x11.ufo.v1.SearchHitR\x04hits\"x\n" +
+	"\x11FindNearbyRequest\x12(\n" +
+	"\x06center\x18\x01 \x01(\v2\x10.ufo.v1.GeoPointR\x06center\x12#\n" +
+	"\rradius_meters\x18\x02 \x01(\x01R\fradiusMeters\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"g\n" +
+	"\x0eNearbySighting\x12,\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\x12'\n" +
+	"\x0fdistance_meters\x18\x02 \x01(\x01R\x0edistanceMeters\"J\n" +
+	"\x12FindNearbyResponse\x124\n" +
+	"\tsightings\x18\x01 \x03(\v2\x16.ufo.v1.NearbySightingR\tsightings\"\xc0\x01\n" +
+	"\x0fGetStatsRequest\x12?\n" +
+	"\robserved_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fobservedFrom\x12;\n" +
+	"\vobserved_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedTo\x12/\n" +
+	"\bgroup_by\x18\x03 \x01(\x0e2\x14.ufo.v1.StatsGroupByR\agroupBy\"g\n" +
+	"\vStatsBucket\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\x88\x01\n" +
+	"\x10GetStatsResponse\x12/\n" +
+	"\bgroup_by\x18\x01 \x01(\x0e2\x14.ufo.v1.StatsGroupByR\agroupBy\x12-\n" +
+	"\abuckets\x18\x02 \x03(\v2\x13.ufo.v1.StatsBucketR\abuckets\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"\\\n" +
+	"\x18UploadAttachmentMetadata\x12#\n" +
+	"\rsighting_uuid\x18\x01 \x01(\tR\fsightingUuid\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\"|\n" +
+	"\x17UploadAttachmentRequest\x12>\n" +
+	"\bmetadata\x18\x01 \x01(\v2 .ufo.v1.UploadAttachmentMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"N\n" +
+	"\x18UploadAttachmentResponse\x122\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x12.ufo.v1.AttachmentR\n" +
+	"attachment\"e\n" +
+	"\x19DownloadAttachmentRequest\x12#\n" +
+	"\rsighting_uuid\x18\x01 \x01(\tR\fsightingUuid\x12#\n" +
+	"\rattachment_id\x18\x02 \x01(\tR\fattachmentId\"u\n" +
+	"\x1aDownloadAttachmentResponse\x124\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x12.ufo.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x99\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x129\n" +
+	"\told_value\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\boldValue\x129\n" +
+	"\tnew_value\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\bnewValue\"\xb1\x02\n" +
+	"\x10SightingRevision\x12#\n" +
+	"\rsighting_uuid\x18\x01 \x01(\tR\fsightingUuid\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12.\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x16.ufo.v1.RevisionActionR\x06action\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12-\n" +
+	"\achanges\x18\x06 \x03(\v2\x13.ufo.v1.FieldChangeR\achanges\x12,\n" +
+	"\bsighting\x18\a \x01(\v2\x10.ufo.v1.SightingR\bsighting\"X\n" +
+	"\x11GetHistoryRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\x84\x01\n" +
+	"\x12GetHistoryResponse\x126\n" +
+	"\trevisions\x18\x01 \x03(\v2\x18.ufo.v1.SightingRevisionR\trevisions\x126\n" +
+	"\x0eas_of_sighting\x18\x02 \x01(\v2\x10.ufo.v1.SightingR\fasOfSighting\"\xa9\x01\n" +
+	"\x15MergeSightingsRequest\x12\x1f\n" +
+	"\vtarget_uuid\x18\x01 \x01(\tR\n" +
+	"targetUuid\x12'\n" +
+	"\x0fduplicate_uuids\x18\x02 \x03(\tR\x0eduplicateUuids\x12F\n" +
+	"\x10expected_version\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\x0fexpectedVersion\"F\n" +
+	"\x16MergeSightingsResponse\x12,\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting*\xdd\x01\n" +
+	"\x11SightingEventType\x12#\n" +
+	"\x1fSIGHTING_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSIGHTING_EVENT_TYPE_CREATED\x10\x01\x12\x1f\n" +
+	"\x1bSIGHTING_EVENT_TYPE_UPDATED\x10\x02\x12\x1f\n" +
+	"\x1bSIGHTING_EVENT_TYPE_DELETED\x10\x03\x12 \n" +
+	"\x1cSIGHTING_EVENT_TYPE_RESTORED\x10\x04\x12\x1e\n" +
+	"\x1aSIGHTING_EVENT_TYPE_PURGED\x10\x05*\xad\x01\n" +
+	"\fStatsGroupBy\x12\x1e\n" +
+	"\x1aSTATS_GROUP_BY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12STATS_GROUP_BY_DAY\x10\x01\x12\x17\n" +
+	"\x13STATS_GROUP_BY_WEEK\x10\x02\x12\x18\n" +
+	"\x14STATS_GROUP_BY_MONTH\x10\x03\x12\x18\n" +
+	"\x14STATS_GROUP_BY_COLOR\x10\x04\x12\x18\n" +
+	"\x14STATS_GROUP_BY_SOUND\x10\x05*\xa6\x01\n" +
+	"\x0eRevisionAction\x12\x1f\n" +
+	"\x1bREVISION_ACTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17REVISION_ACTION_CREATED\x10\x01\x12\x1b\n" +
+	"\x17REVISION_ACTION_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17REVISION_ACTION_DELETED\x10\x03\x12\x1c\n" +
+	"\x18REVISION_ACTION_RESTORED\x10\x042\xbd\t\n" +
 	"\n" +
 	"UFOService\x127\n" +
 	"\x06Create\x12\x15.ufo.v1.CreateRequest\x1a\x16.ufo.v1.CreateResponse\x12.\n" +
 	"\x03Get\x12\x12.ufo.v1.GetRequest\x1a\x13.ufo.v1.GetResponse\x127\n" +
-	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\x127\n" +
-	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.EmptyBFZDgithub.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1;ufov1b\x06proto3"
+	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.ufo.v1.UpdateResponse\x127\n" +
+	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\x121\n" +
+	"\x04List\x12\x13.ufo.v1.ListRequest\x1a\x14.ufo.v1.ListResponse\x129\n" +
+	"\aRestore\x12\x16.ufo.v1.RestoreRequest\x1a\x16.google.protobuf.Empty\x124\n" +
+	"\x05Purge\x12\x14.ufo.v1.PurgeRequest\x1a\x15.ufo.v1.PurgeResponse\x12H\n" +
+	"\x0eWatchSightings\x12\x1d.ufo.v1.WatchSightingsRequest\x1a\x15.ufo.v1.SightingEvent0\x01\x12F\n" +
+	"\vBatchCreate\x12\x1a.ufo.v1.BatchCreateRequest\x1a\x1b.ufo.v1.BatchCreateResponse\x12=\n" +
+	"\bBatchGet\x12\x17.ufo.v1.BatchGetRequest\x1a\x18.ufo.v1.BatchGetResponse\x12T\n" +
+	"\x0fImportSightings\x12\x1e.ufo.v1.ImportSightingsRequest\x1a\x1f.ufo.v1.ImportSightingsResponse(\x01\x127\n" +
+	"\x06Search\x12\x15.ufo.v1.SearchRequest\x1a\x16.ufo.v1.SearchResponse\x12C\n" +
+	"\n" +
+	"FindNearby\x12\x19.ufo.v1.FindNearbyRequest\x1a\x1a.ufo.v1.FindNearbyResponse\x12=\n" +
+	"\bGetStats\x12\x17.ufo.v1.GetStatsRequest\x1a\x18.ufo.v1.GetStatsResponse\x12W\n" +
+	"\x10UploadAttachment\x12\x1f.ufo.v1.UploadAttachmentRequest\x1a .ufo.v1.UploadAttachmentResponse(\x01\x12]\n" +
+	"\x12DownloadAttachment\x12!.ufo.v1.DownloadAttachmentRequest\x1a\".ufo.v1.DownloadAttachmentResponse0\x01\x12C\n" +
+	"\n" +
+	"GetHistory\x12\x19.ufo.v1.GetHistoryRequest\x1a\x1a.ufo.v1.GetHistoryResponse\x12O\n" +
+	"\x0eMergeSightings\x12\x1d.ufo.v1.MergeSightingsRequest\x1a\x1e.ufo.v1.MergeSightingsResponseBFZDgithub.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1;ufov1b\x06proto3"
 
 var (
 	file_ufo_v1_ufo_proto_rawDescOnce sync.Once
@@ -639,54 +3524,180 @@ func file_ufo_v1_ufo_proto_rawDescGZIP() []byte {
 	return file_ufo_v1_ufo_proto_rawDescData
 }
 
-var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ufo_v1_ufo_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_ufo_v1_ufo_proto_goTypes = []any{
-	(*SightingInfo)(nil),           // 0: ufo.v1.SightingInfo
-	(*SightingUpdateInfo)(nil),     // 1: ufo.v1.SightingUpdateInfo
-	(*Sighting)(nil),               // 2: ufo.v1.Sighting
-	(*CreateRequest)(nil),          // 3: ufo.v1.CreateRequest
-	(*CreateResponse)(nil),         // 4: ufo.v1.CreateResponse
-	(*GetRequest)(nil),             // 5: ufo.v1.GetRequest
-	(*GetResponse)(nil),            // 6: ufo.v1.GetResponse
-	(*UpdateRequest)(nil),          // 7: ufo.v1.UpdateRequest
-	(*DeleteRequest)(nil),          // 8: ufo.v1.DeleteRequest
-	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil), // 10: google.protobuf.StringValue
-	(*wrapperspb.BoolValue)(nil),   // 11: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),  // 12: google.protobuf.Int32Value
-	(*emptypb.Empty)(nil),          // 13: google.protobuf.Empty
+	(SightingEventType)(0),             // 0: ufo.v1.SightingEventType
+	(StatsGroupBy)(0),                  // 1: ufo.v1.StatsGroupBy
+	(RevisionAction)(0),                // 2: ufo.v1.RevisionAction
+	(*GeoPoint)(nil),                   // 3: ufo.v1.GeoPoint
+	(*SightingInfo)(nil),               // 4: ufo.v1.SightingInfo
+	(*SightingUpdateInfo)(nil),         // 5: ufo.v1.SightingUpdateInfo
+	(*Sighting)(nil),                   // 6: ufo.v1.Sighting
+	(*Attachment)(nil),                 // 7: ufo.v1.Attachment
+	(*CreateRequest)(nil),              // 8: ufo.v1.CreateRequest
+	(*CreateResponse)(nil),             // 9: ufo.v1.CreateResponse
+	(*GetRequest)(nil),                 // 10: ufo.v1.GetRequest
+	(*GetResponse)(nil),                // 11: ufo.v1.GetResponse
+	(*UpdateRequest)(nil),              // 12: ufo.v1.UpdateRequest
+	(*UpdateResponse)(nil),             // 13: ufo.v1.UpdateResponse
+	(*DeleteRequest)(nil),              // 14: ufo.v1.DeleteRequest
+	(*SightingFilter)(nil),             // 15: ufo.v1.SightingFilter
+	(*ListRequest)(nil),                // 16: ufo.v1.ListRequest
+	(*ListResponse)(nil),               // 17: ufo.v1.ListResponse
+	(*RestoreRequest)(nil),             // 18: ufo.v1.RestoreRequest
+	(*PurgeRequest)(nil),               // 19: ufo.v1.PurgeRequest
+	(*PurgeResponse)(nil),              // 20: ufo.v1.PurgeResponse
+	(*WatchSightingsRequest)(nil),      // 21: ufo.v1.WatchSightingsRequest
+	(*SightingEvent)(nil),              // 22: ufo.v1.SightingEvent
+	(*BatchCreateRequest)(nil),         // 23: ufo.v1.BatchCreateRequest
+	(*BatchCreateResult)(nil),          // 24: ufo.v1.BatchCreateResult
+	(*BatchCreateResponse)(nil),        // 25: ufo.v1.BatchCreateResponse
+	(*BatchGetRequest)(nil),            // 26: ufo.v1.BatchGetRequest
+	(*BatchGetResponse)(nil),           // 27: ufo.v1.BatchGetResponse
+	(*ImportSightingsRequest)(nil),     // 28: ufo.v1.ImportSightingsRequest
+	(*ImportRejection)(nil),            // 29: ufo.v1.ImportRejection
+	(*ImportSightingsResponse)(nil),    // 30: ufo.v1.ImportSightingsResponse
+	(*SearchRequest)(nil),              // 31: ufo.v1.SearchRequest
+	(*SearchHighlight)(nil),            // 32: ufo.v1.SearchHighlight
+	(*SearchHit)(nil),                  // 33: ufo.v1.SearchHit
+	(*SearchResponse)(nil),             // 34: ufo.v1.SearchResponse
+	(*FindNearbyRequest)(nil),          // 35: ufo.v1.FindNearbyRequest
+	(*NearbySighting)(nil),             // 36: ufo.v1.NearbySighting
+	(*FindNearbyResponse)(nil),         // 37: ufo.v1.FindNearbyResponse
+	(*GetStatsRequest)(nil),            // 38: ufo.v1.GetStatsRequest
+	(*StatsBucket)(nil),                // 39: ufo.v1.StatsBucket
+	(*GetStatsResponse)(nil),           // 40: ufo.v1.GetStatsResponse
+	(*UploadAttachmentMetadata)(nil),   // 41: ufo.v1.UploadAttachmentMetadata
+	(*UploadAttachmentRequest)(nil),    // 42: ufo.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),   // 43: ufo.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),  // 44: ufo.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil), // 45: ufo.v1.DownloadAttachmentResponse
+	(*FieldChange)(nil),                // 46: ufo.v1.FieldChange
+	(*SightingRevision)(nil),           // 47: ufo.v1.SightingRevision
+	(*GetHistoryRequest)(nil),          // 48: ufo.v1.GetHistoryRequest
+	(*GetHistoryResponse)(nil),         // 49: ufo.v1.GetHistoryResponse
+	(*MergeSightingsRequest)(nil),      // 50: ufo.v1.MergeSightingsRequest
+	(*MergeSightingsResponse)(nil),     // 51: ufo.v1.MergeSightingsResponse
+	(*timestamppb.Timestamp)(nil),      // 52: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),     // 53: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),       // 54: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),      // 55: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),      // 56: google.protobuf.Int64Value
+	(*fieldmaskpb.FieldMask)(nil),      // 57: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),        // 58: google.protobuf.Duration
+	(*emptypb.Empty)(nil),              // 59: google.protobuf.Empty
 }
 var file_ufo_v1_ufo_proto_depIdxs = []int32{
-	9,  // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
-	10, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
-	11, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
-	12, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
-	9,  // 4: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
-	10, // 5: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
-	10, // 6: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
-	10, // 7: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
-	11, // 8: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
-	12, // 9: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
-	0,  // 10: ufo.v1.Sighting.info:type_name -> ufo.v1.SightingInfo
-	9,  // 11: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 12: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 13: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
-	0,  // 14: ufo.v1.CreateRequest.info:type_name -> ufo.v1.SightingInfo
-	2,  // 15: ufo.v1.GetResponse.sighting:type_name -> ufo.v1.Sighting
-	1,  // 16: ufo.v1.UpdateRequest.update_info:type_name -> ufo.v1.SightingUpdateInfo
-	3,  // 17: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
-	5,  // 18: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
-	7,  // 19: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
-	8,  // 20: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
-	4,  // 21: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
-	6,  // 22: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
-	13, // 23: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
-	13, // 24: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
-	21, // [21:25] is the sub-list for method output_type
-	17, // [17:21] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	52, // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
+	53, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
+	54, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
+	55, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	3,  // 4: ufo.v1.SightingInfo.coordinates:type_name -> ufo.v1.GeoPoint
+	52, // 5: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
+	53, // 6: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
+	53, // 7: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
+	53, // 8: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
+	54, // 9: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
+	55, // 10: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	3,  // 11: ufo.v1.SightingUpdateInfo.coordinates:type_name -> ufo.v1.GeoPoint
+	4,  // 12: ufo.v1.Sighting.info:type_name -> ufo.v1.SightingInfo
+	52, // 13: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
+	52, // 14: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
+	52, // 15: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
+	7,  // 16: ufo.v1.Sighting.attachments:type_name -> ufo.v1.Attachment
+	52, // 17: ufo.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 18: ufo.v1.CreateRequest.info:type_name -> ufo.v1.SightingInfo
+	6,  // 19: ufo.v1.CreateResponse.possible_duplicates:type_name -> ufo.v1.Sighting
+	6,  // 20: ufo.v1.GetResponse.sighting:type_name -> ufo.v1.Sighting
+	5,  // 21: ufo.v1.UpdateRequest.update_info:type_name -> ufo.v1.SightingUpdateInfo
+	56, // 22: ufo.v1.UpdateRequest.expected_version:type_name -> google.protobuf.Int64Value
+	57, // 23: ufo.v1.UpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 24: ufo.v1.UpdateResponse.sighting:type_name -> ufo.v1.Sighting
+	56, // 25: ufo.v1.DeleteRequest.expected_version:type_name -> google.protobuf.Int64Value
+	52, // 26: ufo.v1.SightingFilter.observed_from:type_name -> google.protobuf.Timestamp
+	52, // 27: ufo.v1.SightingFilter.observed_to:type_name -> google.protobuf.Timestamp
+	53, // 28: ufo.v1.SightingFilter.location:type_name -> google.protobuf.StringValue
+	53, // 29: ufo.v1.SightingFilter.color:type_name -> google.protobuf.StringValue
+	54, // 30: ufo.v1.SightingFilter.sound:type_name -> google.protobuf.BoolValue
+	15, // 31: ufo.v1.ListRequest.filter:type_name -> ufo.v1.SightingFilter
+	6,  // 32: ufo.v1.ListResponse.sightings:type_name -> ufo.v1.Sighting
+	0,  // 33: ufo.v1.SightingEvent.type:type_name -> ufo.v1.SightingEventType
+	6,  // 34: ufo.v1.SightingEvent.sighting:type_name -> ufo.v1.Sighting
+	52, // 35: ufo.v1.SightingEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	4,  // 36: ufo.v1.BatchCreateRequest.infos:type_name -> ufo.v1.SightingInfo
+	24, // 37: ufo.v1.BatchCreateResponse.results:type_name -> ufo.v1.BatchCreateResult
+	6,  // 38: ufo.v1.BatchGetResponse.sightings:type_name -> ufo.v1.Sighting
+	4,  // 39: ufo.v1.ImportSightingsRequest.info:type_name -> ufo.v1.SightingInfo
+	29, // 40: ufo.v1.ImportSightingsResponse.rejections:type_name -> ufo.v1.ImportRejection
+	58, // 41: ufo.v1.ImportSightingsResponse.duration:type_name -> google.protobuf.Duration
+	6,  // 42: ufo.v1.SearchHit.sighting:type_name -> ufo.v1.Sighting
+	32, // 43: ufo.v1.SearchHit.highlights:type_name -> ufo.v1.SearchHighlight
+	33, // 44: ufo.v1.SearchResponse.hits:type_name -> ufo.v1.SearchHit
+	3,  // 45: ufo.v1.FindNearbyRequest.center:type_name -> ufo.v1.GeoPoint
+	6,  // 46: ufo.v1.NearbySighting.sighting:type_name -> ufo.v1.Sighting
+	36, // 47: ufo.v1.FindNearbyResponse.sightings:type_name -> ufo.v1.NearbySighting
+	52, // 48: ufo.v1.GetStatsRequest.observed_from:type_name -> google.protobuf.Timestamp
+	52, // 49: ufo.v1.GetStatsRequest.observed_to:type_name -> google.protobuf.Timestamp
+	1,  // 50: ufo.v1.GetStatsRequest.group_by:type_name -> ufo.v1.StatsGroupBy
+	52, // 51: ufo.v1.StatsBucket.start:type_name -> google.protobuf.Timestamp
+	1,  // 52: ufo.v1.GetStatsResponse.group_by:type_name -> ufo.v1.StatsGroupBy
+	39, // 53: ufo.v1.GetStatsResponse.buckets:type_name -> ufo.v1.StatsBucket
+	41, // 54: ufo.v1.UploadAttachmentRequest.metadata:type_name -> ufo.v1.UploadAttachmentMetadata
+	7,  // 55: ufo.v1.UploadAttachmentResponse.attachment:type_name -> ufo.v1.Attachment
+	7,  // 56: ufo.v1.DownloadAttachmentResponse.attachment:type_name -> ufo.v1.Attachment
+	53, // 57: ufo.v1.FieldChange.old_value:type_name -> google.protobuf.StringValue
+	53, // 58: ufo.v1.FieldChange.new_value:type_name -> google.protobuf.StringValue
+	2,  // 59: ufo.v1.SightingRevision.action:type_name -> ufo.v1.RevisionAction
+	52, // 60: ufo.v1.SightingRevision.occurred_at:type_name -> google.protobuf.Timestamp
+	46, // 61: ufo.v1.SightingRevision.changes:type_name -> ufo.v1.FieldChange
+	6,  // 62: ufo.v1.SightingRevision.sighting:type_name -> ufo.v1.Sighting
+	52, // 63: ufo.v1.GetHistoryRequest.as_of:type_name -> google.protobuf.Timestamp
+	47, // 64: ufo.v1.GetHistoryResponse.revisions:type_name -> ufo.v1.SightingRevision
+	6,  // 65: ufo.v1.GetHistoryResponse.as_of_sighting:type_name -> ufo.v1.Sighting
+	56, // 66: ufo.v1.MergeSightingsRequest.expected_version:type_name -> google.protobuf.Int64Value
+	6,  // 67: ufo.v1.MergeSightingsResponse.sighting:type_name -> ufo.v1.Sighting
+	8,  // 68: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
+	10, // 69: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
+	12, // 70: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
+	14, // 71: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
+	16, // 72: ufo.v1.UFOService.List:input_type -> ufo.v1.ListRequest
+	18, // 73: ufo.v1.UFOService.Restore:input_type -> ufo.v1.RestoreRequest
+	19, // 74: ufo.v1.UFOService.Purge:input_type -> ufo.v1.PurgeRequest
+	21, // 75: ufo.v1.UFOService.WatchSightings:input_type -> ufo.v1.WatchSightingsRequest
+	23, // 76: ufo.v1.UFOService.BatchCreate:input_type -> ufo.v1.BatchCreateRequest
+	26, // 77: ufo.v1.UFOService.BatchGet:input_type -> ufo.v1.BatchGetRequest
+	28, // 78: ufo.v1.UFOService.ImportSightings:input_type -> ufo.v1.ImportSightingsRequest
+	31, // 79: ufo.v1.UFOService.Search:input_type -> ufo.v1.SearchRequest
+	35, // 80: ufo.v1.UFOService.FindNearby:input_type -> ufo.v1.FindNearbyRequest
+	38, // 81: ufo.v1.UFOService.GetStats:input_type -> ufo.v1.GetStatsRequest
+	42, // 82: ufo.v1.UFOService.UploadAttachment:input_type -> ufo.v1.UploadAttachmentRequest
+	44, // 83: ufo.v1.UFOService.DownloadAttachment:input_type -> ufo.v1.DownloadAttachmentRequest
+	48, // 84: ufo.v1.UFOService.GetHistory:input_type -> ufo.v1.GetHistoryRequest
+	50, // 85: ufo.v1.UFOService.MergeSightings:input_type -> ufo.v1.MergeSightingsRequest
+	9,  // 86: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
+	11, // 87: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
+	13, // 88: ufo.v1.UFOService.Update:output_type -> ufo.v1.UpdateResponse
+	59, // 89: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
+	17, // 90: ufo.v1.UFOService.List:output_type -> ufo.v1.ListResponse
+	59, // 91: ufo.v1.UFOService.Restore:output_type -> google.protobuf.Empty
+	20, // 92: ufo.v1.UFOService.Purge:output_type -> ufo.v1.PurgeResponse
+	22, // 93: ufo.v1.UFOService.WatchSightings:output_type -> ufo.v1.SightingEvent
+	25, // 94: ufo.v1.UFOService.BatchCreate:output_type -> ufo.v1.BatchCreateResponse
+	27, // 95: ufo.v1.UFOService.BatchGet:output_type -> ufo.v1.BatchGetResponse
+	30, // 96: ufo.v1.UFOService.ImportSightings:output_type -> ufo.v1.ImportSightingsResponse
+	34, // 97: ufo.v1.UFOService.Search:output_type -> ufo.v1.SearchResponse
+	37, // 98: ufo.v1.UFOService.FindNearby:output_type -> ufo.v1.FindNearbyResponse
+	40, // 99: ufo.v1.UFOService.GetStats:output_type -> ufo.v1.GetStatsResponse
+	43, // 100: ufo.v1.UFOService.UploadAttachment:output_type -> ufo.v1.UploadAttachmentResponse
+	45, // 101: ufo.v1.UFOService.DownloadAttachment:output_type -> ufo.v1.DownloadAttachmentResponse
+	49, // 102: ufo.v1.UFOService.GetHistory:output_type -> ufo.v1.GetHistoryResponse
+	51, // 103: ufo.v1.UFOService.MergeSightings:output_type -> ufo.v1.MergeSightingsResponse
+	86, // [86:104] is the sub-list for method output_type
+	68, // [68:86] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_ufo_v1_ufo_proto_init() }
@@ -694,18 +3705,31 @@ func file_ufo_v1_ufo_proto_init() {
 	if File_ufo_v1_ufo_proto != nil {
 		return
 	}
+	file_ufo_v1_ufo_proto_msgTypes[21].OneofWrappers = []any{
+		(*BatchCreateResult_Uuid)(nil),
+		(*BatchCreateResult_Error)(nil),
+	}
+	file_ufo_v1_ufo_proto_msgTypes[39].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Metadata)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_ufo_v1_ufo_proto_msgTypes[42].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ufo_v1_ufo_proto_rawDesc), len(file_ufo_v1_ufo_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ufo_v1_ufo_proto_goTypes,
 		DependencyIndexes: file_ufo_v1_ufo_proto_depIdxs,
+		EnumInfos:         file_ufo_v1_ufo_proto_enumTypes,
 		MessageInfos:      file_ufo_v1_ufo_proto_msgTypes,
 	}.Build()
 	File_ufo_v1_ufo_proto = out.File
@@ -22,10 +22,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UFOService_Create_FullMethodName = "/ufo.v1.UFOService/Create"
-	UFOService_Get_FullMethodName    = "/ufo.v1.UFOService/Get"
-	UFOService_Update_FullMethodName = "/ufo.v1.UFOService/Update"
-	UFOService_Delete_FullMethodName = "/ufo.v1.UFOService/Delete"
+	UFOService_Create_FullMethodName             = "/ufo.v1.UFOService/Create"
+	UFOService_Get_FullMethodName                = "/ufo.v1.UFOService/Get"
+	UFOService_Update_FullMethodName             = "/ufo.v1.UFOService/Update"
+	UFOService_Delete_FullMethodName             = "/ufo.v1.UFOService/Delete"
+	UFOService_List_FullMethodName               = "/ufo.v1.UFOService/List"
+	UFOService_Restore_FullMethodName            = "/ufo.v1.UFOService/Restore"
+	UFOService_Purge_FullMethodName              = "/ufo.v1.UFOService/Purge"
+	UFOService_WatchSightings_FullMethodName     = "/ufo.v1.UFOService/WatchSightings"
+	UFOService_BatchCreate_FullMethodName        = "/ufo.v1.UFOService/BatchCreate"
+	UFOService_BatchGet_FullMethodName           = "/ufo.v1.UFOService/BatchGet"
+	UFOService_ImportSightings_FullMethodName    = "/ufo.v1.UFOService/ImportSightings"
+	UFOService_Search_FullMethodName             = "/ufo.v1.UFOService/Search"
+	UFOService_FindNearby_FullMethodName         = "/ufo.v1.UFOService/FindNearby"
+	UFOService_GetStats_FullMethodName           = "/ufo.v1.UFOService/GetStats"
+	UFOService_UploadAttachment_FullMethodName   = "/ufo.v1.UFOService/UploadAttachment"
+	UFOService_DownloadAttachment_FullMethodName = "/ufo.v1.UFOService/DownloadAttachment"
+	UFOService_GetHistory_FullMethodName         = "/ufo.v1.UFOService/GetHistory"
+	UFOService_MergeSightings_FullMethodName     = "/ufo.v1.UFOService/MergeSightings"
 )
 
 // UFOServiceClient is the client API for UFOService service.
@@ -36,12 +50,42 @@ const (
 type UFOServiceClient interface {
 	// Create создает новое наблюдение НЛО
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
-	// Get возвращает наблюдение НЛО по идентификатору
+	// Get возвращает наблюдение НЛО по идентификатору (мягко удаленные не возвращаются)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// Update обновляет существующее наблюдение НЛО
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Update обновляет существующее наблюдение НЛО и возвращает его актуальное состояние
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List возвращает страницу наблюдений НЛО с учетом фильтров
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Restore восстанавливает мягко удаленное наблюдение НЛО
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Purge окончательно удаляет наблюдения, мягко удаленные раньше заданного срока (административная операция)
+	Purge(ctx context.Context, in *PurgeRequest, opts ...grpc.CallOption) (*PurgeResponse, error)
+	// WatchSightings транслирует события изменения наблюдений НЛО по мере их появления
+	WatchSightings(ctx context.Context, in *WatchSightingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SightingEvent], error)
+	// BatchCreate создает несколько наблюдений НЛО за один запрос, результат возвращается для каждого элемента
+	BatchCreate(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error)
+	// BatchGet возвращает несколько наблюдений НЛО по идентификаторам
+	BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error)
+	// ImportSightings принимает поток наблюдений НЛО, сохраняет их пакетами и возвращает итог импорта
+	ImportSightings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportSightingsRequest, ImportSightingsResponse], error)
+	// Search выполняет полнотекстовый поиск по месту и описанию наблюдений, самые релевантные первыми
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// FindNearby возвращает наблюдения в радиусе от точки, ближайшие первыми
+	FindNearby(ctx context.Context, in *FindNearbyRequest, opts ...grpc.CallOption) (*FindNearbyResponse, error)
+	// GetStats возвращает количество наблюдений за период, сгруппированное по времени, цвету или звуку
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// UploadAttachment принимает вложение (фото или видео) к наблюдению по частям:
+	// первое сообщение содержит метаданные, следующие - содержимое файла
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	// DownloadAttachment отдает вложение наблюдения: первое сообщение содержит метаданные, следующие - содержимое
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	// GetHistory возвращает ревизии наблюдения по порядку версий и, если задан as_of, состояние наблюдения на этот момент
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	// MergeSightings объединяет дубликаты с основным наблюдением: пустые поля основного наблюдения
+	// заполняются из дубликатов, а дубликаты мягко удаляются
+	MergeSightings(ctx context.Context, in *MergeSightingsRequest, opts ...grpc.CallOption) (*MergeSightingsResponse, error)
 }
 
 type uFOServiceClient struct {
@@ -72,9 +116,9 @@ func (c *uFOServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc
 	return out, nil
 }
 
-func (c *uFOServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *uFOServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(UpdateResponse)
 	err := c.cc.Invoke(ctx, UFOService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -92,6 +136,170 @@ func (c *uFOServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts .
 	return out, nil
 }
 
+func (c *uFOServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, UFOService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UFOService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) Purge(ctx context.Context, in *PurgeRequest, opts ...grpc.CallOption) (*PurgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeResponse)
+	err := c.cc.Invoke(ctx, UFOService_Purge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) WatchSightings(ctx context.Context, in *WatchSightingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SightingEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[0], UFOService_WatchSightings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSightingsRequest, SightingEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_WatchSightingsClient = grpc.ServerStreamingClient[SightingEvent]
+
+func (c *uFOServiceClient) BatchCreate(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateResponse)
+	err := c.cc.Invoke(ctx, UFOService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetResponse)
+	err := c.cc.Invoke(ctx, UFOService_BatchGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) ImportSightings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportSightingsRequest, ImportSightingsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[1], UFOService_ImportSightings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportSightingsRequest, ImportSightingsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_ImportSightingsClient = grpc.ClientStreamingClient[ImportSightingsRequest, ImportSightingsResponse]
+
+func (c *uFOServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, UFOService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) FindNearby(ctx context.Context, in *FindNearbyRequest, opts ...grpc.CallOption) (*FindNearbyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNearbyResponse)
+	err := c.cc.Invoke(ctx, UFOService_FindNearby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, UFOService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[2], UFOService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *uFOServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[3], UFOService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *uFOServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, UFOService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) MergeSightings(ctx context.Context, in *MergeSightingsRequest, opts ...grpc.CallOption) (*MergeSightingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeSightingsResponse)
+	err := c.cc.Invoke(ctx, UFOService_MergeSightings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UFOServiceServer is the server API for UFOService service.
 // All implementations must embed UnimplementedUFOServiceServer
 // for forward compatibility.
@@ -100,12 +308,42 @@ func (c *uFOServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts .
 type UFOServiceServer interface {
 	// Create создает новое наблюдение НЛО
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
-	// Get возвращает наблюдение НЛО по идентификатору
+	// Get возвращает наблюдение НЛО по идентификатору (мягко удаленные не возвращаются)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// Update обновляет существующее наблюдение НЛО
-	Update(context.Context, *UpdateRequest) (*emptypb.Empty, error)
+	// Update обновляет существующее наблюдение НЛО и возвращает его актуальное состояние
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// List возвращает страницу наблюдений НЛО с учетом фильтров
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Restore восстанавливает мягко удаленное наблюдение НЛО
+	Restore(context.Context, *RestoreRequest) (*emptypb.Empty, error)
+	// Purge окончательно удаляет наблюдения, мягко удаленные раньше заданного срока (административная операция)
+	Purge(context.Context, *PurgeRequest) (*PurgeResponse, error)
+	// WatchSightings транслирует события изменения наблюдений НЛО по мере их появления
+	WatchSightings(*WatchSightingsRequest, grpc.ServerStreamingServer[SightingEvent]) error
+	// BatchCreate создает несколько наблюдений НЛО за один запрос, результат возвращается для каждого элемента
+	BatchCreate(context.Context, *BatchCreateRequest) (*BatchCreateResponse, error)
+	// BatchGet возвращает несколько наблюдений НЛО по идентификаторам
+	BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error)
+	// ImportSightings принимает поток наблюдений НЛО, сохраняет их пакетами и возвращает итог импорта
+	ImportSightings(grpc.ClientStreamingServer[ImportSightingsRequest, ImportSightingsResponse]) error
+	// Search выполняет полнотекстовый поиск по месту и описанию наблюдений, самые релевантные первыми
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// FindNearby возвращает наблюдения в радиусе от точки, ближайшие первыми
+	FindNearby(context.Context, *FindNearbyRequest) (*FindNearbyResponse, error)
+	// GetStats возвращает количество наблюдений за период, сгруппированное по времени, цвету или звуку
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// UploadAttachment принимает вложение (фото или видео) к наблюдению по частям:
+	// первое сообщение содержит метаданные, следующие - содержимое файла
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	// DownloadAttachment отдает вложение наблюдения: первое сообщение содержит метаданные, следующие - содержимое
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	// GetHistory возвращает ревизии наблюдения по порядку версий и, если задан as_of, состояние наблюдения на этот момент
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	// MergeSightings объединяет дубликаты с основным наблюдением: пустые поля основного наблюдения
+	// заполняются из дубликатов, а дубликаты мягко удаляются
+	MergeSightings(context.Context, *MergeSightingsRequest) (*MergeSightingsResponse, error)
 	mustEmbedUnimplementedUFOServiceServer()
 }
 
@@ -122,12 +360,54 @@ func (UnimplementedUFOServiceServer) Create(context.Context, *CreateRequest) (*C
 func (UnimplementedUFOServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedUFOServiceServer) Update(context.Context, *UpdateRequest) (*emptypb.Empty, error) {
+func (UnimplementedUFOServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
 func (UnimplementedUFOServiceServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUFOServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedUFOServiceServer) Restore(context.Context, *RestoreRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedUFOServiceServer) Purge(context.Context, *PurgeRequest) (*PurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
+func (UnimplementedUFOServiceServer) WatchSightings(*WatchSightingsRequest, grpc.ServerStreamingServer[SightingEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSightings not implemented")
+}
+func (UnimplementedUFOServiceServer) BatchCreate(context.Context, *BatchCreateRequest) (*BatchCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedUFOServiceServer) BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedUFOServiceServer) ImportSightings(grpc.ClientStreamingServer[ImportSightingsRequest, ImportSightingsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSightings not implemented")
+}
+func (UnimplementedUFOServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedUFOServiceServer) FindNearby(context.Context, *FindNearbyRequest) (*FindNearbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearby not implemented")
+}
+func (UnimplementedUFOServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedUFOServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedUFOServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedUFOServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedUFOServiceServer) MergeSightings(context.Context, *MergeSightingsRequest) (*MergeSightingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeSightings not implemented")
+}
 func (UnimplementedUFOServiceServer) mustEmbedUnimplementedUFOServiceServer() {}
 func (UnimplementedUFOServiceServer) testEmbeddedByValue()                    {}
 
@@ -221,6 +501,222 @@ func _UFOService_Delete_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UFOService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_Purge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).Purge(ctx, req.(*PurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_WatchSightings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSightingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UFOServiceServer).WatchSightings(m, &grpc.GenericServerStream[WatchSightingsRequest, SightingEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_WatchSightingsServer = grpc.ServerStreamingServer[SightingEvent]
+
+func _UFOService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).BatchCreate(ctx, req.(*BatchCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_BatchGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).BatchGet(ctx, req.(*BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_ImportSightings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UFOServiceServer).ImportSightings(&grpc.GenericServerStream[ImportSightingsRequest, ImportSightingsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_ImportSightingsServer = grpc.ClientStreamingServer[ImportSightingsRequest, ImportSightingsResponse]
+
+func _UFOService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_FindNearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).FindNearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_FindNearby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).FindNearby(ctx, req.(*FindNearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UFOServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _UFOService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UFOServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _UFOService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_MergeSightings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeSightingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).MergeSightings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_MergeSightings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).MergeSightings(ctx, req.(*MergeSightingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UFOService_ServiceDesc is the grpc.ServiceDesc for UFOService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -244,7 +740,68 @@ var UFOService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UFOService_Delete_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _UFOService_List_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _UFOService_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _UFOService_Purge_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _UFOService_BatchCreate_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _UFOService_BatchGet_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _UFOService_Search_Handler,
+		},
+		{
+			MethodName: "FindNearby",
+			Handler:    _UFOService_FindNearby_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _UFOService_GetStats_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _UFOService_GetHistory_Handler,
+		},
+		{
+			MethodName: "MergeSightings",
+			Handler:    _UFOService_MergeSightings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSightings",
+			Handler:       _UFOService_WatchSightings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSightings",
+			Handler:       _UFOService_ImportSightings_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _UFOService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _UFOService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ufo/v1/ufo.proto",
 }
@@ -8,11 +8,13 @@ import (
 type api struct {
 	ufoV1.UnimplementedUFOServiceServer
 
-	ufoService service.UFOService
+	ufoService        service.UFOService
+	attachmentService service.AttachmentService
 }
 
-func NewAPI(ufoService service.UFOService) *api {
+func NewAPI(ufoService service.UFOService, attachmentService service.AttachmentService) *api {
 	return &api{
-		ufoService: ufoService,
+		ufoService:        ufoService,
+		attachmentService: attachmentService,
 	}
 }
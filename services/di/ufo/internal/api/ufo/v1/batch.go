@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (a *api) BatchCreate(ctx context.Context, req *ufoV1.BatchCreateRequest) (*ufoV1.BatchCreateResponse, error) {
+	infos := make([]model.SightingInfo, 0, len(req.GetInfos()))
+	for i, info := range req.GetInfos() {
+		if info == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "infos[%d] is empty", i)
+		}
+		infos = append(infos, converter.UFOInfoToModel(info))
+	}
+
+	results, err := a.ufoService.BatchCreate(ctx, infos)
+	if err != nil {
+		return nil, batchStatus(err)
+	}
+
+	return converter.BatchCreateResultsToProto(results), nil
+}
+
+func (a *api) BatchGet(ctx context.Context, req *ufoV1.BatchGetRequest) (*ufoV1.BatchGetResponse, error) {
+	batch, err := a.ufoService.BatchGet(ctx, req.GetUuids())
+	if err != nil {
+		return nil, batchStatus(err)
+	}
+
+	return converter.SightingBatchToProto(batch), nil
+}
+
+// batchStatus приводит ошибку пакетной операции к gRPC статусу
+func batchStatus(err error) error {
+	if st, ok := validationStatus(err, "infos"); ok {
+		return st
+	}
+
+	switch {
+	case errors.Is(err, model.ErrEmptyBatch):
+		return status.Error(codes.InvalidArgument, "batch must contain at least one item")
+	case errors.Is(err, model.ErrBatchTooLarge), errors.Is(err, model.ErrInvalidCoordinates):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
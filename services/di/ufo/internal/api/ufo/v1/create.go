@@ -2,18 +2,53 @@ package v1
 
 import (
 	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 )
 
+// IdempotencyKeyHeader ключ метаданных, по которому повторный Create возвращает исходный результат
+const IdempotencyKeyHeader = "idempotency-key"
+
 func (a *api) Create(ctx context.Context, req *ufoV1.CreateRequest) (*ufoV1.CreateResponse, error) {
-	uuid, err := a.ufoService.Create(ctx, converter.UFOInfoToModel(req.GetInfo()))
+	info := converter.UFOInfoToModel(req.GetInfo())
+
+	var (
+		created model.CreatedSighting
+		err     error
+	)
+	if key, ok := idempotencyKey(ctx); ok {
+		created, err = a.ufoService.CreateIdempotent(ctx, info, key)
+	} else {
+		created, err = a.ufoService.Create(ctx, info)
+	}
 	if err != nil {
+		if st, ok := validationStatus(err, "info"); ok {
+			return nil, st
+		}
+		if errors.Is(err, model.ErrInvalidCoordinates) || errors.Is(err, model.ErrInvalidIdempotencyKey) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, model.ErrIdempotencyKeyReused) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, err
 	}
 
-	return &ufoV1.CreateResponse{
-		Uuid: uuid,
-	}, nil
+	return converter.CreatedSightingToProto(created), nil
+}
+
+func idempotencyKey(ctx context.Context) (string, bool) {
+	values := metadata.ValueFromIncomingContext(ctx, IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	return values[0], true
 }
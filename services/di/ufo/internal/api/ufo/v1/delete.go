@@ -9,15 +9,22 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 )
 
 func (a *api) Delete(ctx context.Context, req *ufoV1.DeleteRequest) (*emptypb.Empty, error) {
-	err := a.ufoService.Delete(ctx, req.GetUuid())
+	err := a.ufoService.Delete(ctx, req.GetUuid(), converter.ExpectedVersionToModel(req.GetExpectedVersion()))
 	if err != nil {
 		if errors.Is(err, model.ErrSightingNotFound) {
 			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
 		}
+		if errors.Is(err, model.ErrSightingDeleted) {
+			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s is deleted", req.GetUuid())
+		}
+		if errors.Is(err, model.ErrVersionConflict) {
+			return nil, status.Errorf(codes.Aborted, "sighting with UUID %s was modified concurrently", req.GetUuid())
+		}
 		return nil, err
 	}
 
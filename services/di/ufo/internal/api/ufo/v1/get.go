@@ -18,6 +18,9 @@ func (a *api) Get(ctx context.Context, req *ufoV1.GetRequest) (*ufoV1.GetRespons
 		if errors.Is(err, model.ErrSightingNotFound) {
 			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
 		}
+		if errors.Is(err, model.ErrSightingDeleted) {
+			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s is deleted", req.GetUuid())
+		}
 		return nil, err
 	}
 
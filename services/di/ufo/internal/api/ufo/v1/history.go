@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (a *api) GetHistory(ctx context.Context, req *ufoV1.GetHistoryRequest) (*ufoV1.GetHistoryResponse, error) {
+	history, err := a.ufoService.GetHistory(ctx, converter.HistoryRequestToModel(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrSightingNotFound):
+			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
+		case errors.Is(err, model.ErrNoSightingAsOf):
+			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s did not exist at %s",
+				req.GetUuid(), req.GetAsOf().AsTime().Format(time.RFC3339))
+		default:
+			return nil, err
+		}
+	}
+
+	return converter.HistoryToProto(history), nil
+}
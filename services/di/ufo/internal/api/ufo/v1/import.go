@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/status"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (a *api) ImportSightings(stream ufoV1.UFOService_ImportSightingsServer) error {
+	ctx := stream.Context()
+
+	summary, err := a.ufoService.Import(ctx, importSource{stream: stream})
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return status.FromContextError(err).Err()
+		}
+		return err
+	}
+
+	return stream.SendAndClose(converter.ImportSummaryToProto(summary))
+}
+
+// importSource читает импортируемые наблюдения из клиентского стрима.
+// io.EOF от Recv означает, что клиент закончил отправку, и передается как есть.
+type importSource struct {
+	stream ufoV1.UFOService_ImportSightingsServer
+}
+
+func (s importSource) Next(_ context.Context) (model.SightingInfo, error) {
+	req, err := s.stream.Recv()
+	if err != nil {
+		return model.SightingInfo{}, err
+	}
+
+	if req.GetInfo() == nil {
+		return model.SightingInfo{}, fmt.Errorf("%w: info is empty", model.ErrInvalidImportItem)
+	}
+
+	return converter.UFOInfoToModel(req.GetInfo()), nil
+}
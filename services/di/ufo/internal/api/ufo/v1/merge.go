@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (a *api) MergeSightings(ctx context.Context, req *ufoV1.MergeSightingsRequest) (*ufoV1.MergeSightingsResponse, error) {
+	sighting, err := a.ufoService.Merge(ctx, converter.MergeRequestToModel(req))
+	if err != nil {
+		// Ошибка содержит UUID наблюдения, на котором объединение остановилось
+		if errors.Is(err, model.ErrSightingNotFound) || errors.Is(err, model.ErrSightingDeleted) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, model.ErrVersionConflict) {
+			return nil, status.Errorf(codes.Aborted, "sighting with UUID %s was modified concurrently", req.GetTargetUuid())
+		}
+		if errors.Is(err, model.ErrInvalidMerge) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &ufoV1.MergeSightingsResponse{
+		Sighting: converter.SightingToProto(sighting),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (a *api) FindNearby(ctx context.Context, req *ufoV1.FindNearbyRequest) (*ufoV1.FindNearbyResponse, error) {
+	if req.GetCenter() == nil {
+		return nil, status.Error(codes.InvalidArgument, "center cannot be nil")
+	}
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit cannot be negative")
+	}
+
+	sightings, err := a.ufoService.FindNearby(ctx, model.NearbyQuery{
+		Center:       *converter.GeoPointToModel(req.GetCenter()),
+		RadiusMeters: req.GetRadiusMeters(),
+		Limit:        req.GetLimit(),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidCoordinates) || errors.Is(err, model.ErrInvalidRadius) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return converter.NearbySightingsToProto(sightings), nil
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+)
+
+func (a *api) Purge(ctx context.Context, req *ufoV1.PurgeRequest) (*ufoV1.PurgeResponse, error) {
+	// 0 - значение по умолчанию в proto: пустой запрос не должен удалять все сразу
+	if req.GetOlderThanDays() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "older_than_days must be positive")
+	}
+
+	olderThan := time.Duration(req.GetOlderThanDays()) * 24 * time.Hour
+
+	purged, err := a.ufoService.Purge(ctx, olderThan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ufoV1.PurgeResponse{
+		PurgedCount: purged,
+	}, nil
+}
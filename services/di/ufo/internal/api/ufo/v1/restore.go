@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (a *api) Restore(ctx context.Context, req *ufoV1.RestoreRequest) (*emptypb.Empty, error) {
+	err := a.ufoService.Restore(ctx, req.GetUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrSightingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
+		}
+		if errors.Is(err, model.ErrSightingNotDeleted) {
+			return nil, status.Errorf(codes.FailedPrecondition, "sighting with UUID %s is not deleted", req.GetUuid())
+		}
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
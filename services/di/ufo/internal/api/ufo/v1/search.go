@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (a *api) Search(ctx context.Context, req *ufoV1.SearchRequest) (*ufoV1.SearchResponse, error) {
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit cannot be negative")
+	}
+
+	hits, err := a.ufoService.Search(ctx, req.GetQuery(), req.GetLimit())
+	if err != nil {
+		if errors.Is(err, model.ErrEmptySearchQuery) {
+			return nil, status.Error(codes.InvalidArgument, "query must contain at least one word")
+		}
+		return nil, err
+	}
+
+	return converter.SearchHitsToProto(hits), nil
+}
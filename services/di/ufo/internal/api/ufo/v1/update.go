@@ -3,28 +3,80 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 )
 
-func (a *api) Update(ctx context.Context, req *ufoV1.UpdateRequest) (*emptypb.Empty, error) {
-	if req.UpdateInfo == nil {
+func (a *api) Update(ctx context.Context, req *ufoV1.UpdateRequest) (*ufoV1.UpdateResponse, error) {
+	// С маской update_info может отсутствовать: тогда все поля из маски очищаются
+	if len(req.GetUpdateMask().GetPaths()) == 0 && req.UpdateInfo == nil {
 		return nil, status.Error(codes.InvalidArgument, "update_info cannot be nil")
 	}
 
-	err := a.ufoService.Update(ctx, req.GetUuid(), converter.UpdateInfoToModel(req.GetUpdateInfo()))
+	err := checkUpdateMask(req.GetUpdateMask(), req.GetUpdateInfo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sighting, err := a.ufoService.Update(
+		ctx,
+		req.GetUuid(),
+		converter.UpdateRequestToModel(req),
+		converter.ExpectedVersionToModel(req.GetExpectedVersion()),
+	)
 	if err != nil {
 		if errors.Is(err, model.ErrSightingNotFound) {
 			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
 		}
+		if errors.Is(err, model.ErrSightingDeleted) {
+			return nil, status.Errorf(codes.NotFound, "sighting with UUID %s is deleted", req.GetUuid())
+		}
+		if errors.Is(err, model.ErrVersionConflict) {
+			return nil, status.Errorf(codes.Aborted, "sighting with UUID %s was modified concurrently", req.GetUuid())
+		}
+		if st, ok := validationStatus(err, "update_info"); ok {
+			return nil, st
+		}
+		if errors.Is(err, model.ErrInvalidCoordinates) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return &ufoV1.UpdateResponse{
+		Sighting: converter.SightingToProto(sighting),
+	}, nil
+}
+
+// requiredUpdateFields поля наблюдения, которые нельзя очистить через update_mask
+var requiredUpdateFields = map[string]bool{
+	"location":    true,
+	"description": true,
+}
+
+// checkUpdateMask разрешает в маске только поля SightingUpdateInfo верхнего уровня:
+// координаты меняются и очищаются целиком. Обязательное поле из маски должно быть задано
+// в update_info, иначе маска очистила бы его
+func checkUpdateMask(mask *fieldmaskpb.FieldMask, updateInfo *ufoV1.SightingUpdateInfo) error {
+	message := updateInfo.ProtoReflect()
+	fields := message.Descriptor().Fields()
+	for _, path := range mask.GetPaths() {
+		field := fields.ByName(protoreflect.Name(path))
+		if field == nil {
+			return fmt.Errorf("invalid update_mask path %q", path)
+		}
+		if requiredUpdateFields[path] && !message.Has(field) {
+			return fmt.Errorf("update_mask path %q cannot clear a required field", path)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+)
+
+func TestCheckUpdateMask(t *testing.T) {
+	tests := []struct {
+		path       string
+		updateInfo *ufoV1.SightingUpdateInfo
+		wantErr    bool
+	}{
+		// Необязательные поля очищаются маской без значения
+		{path: "observed_at"},
+		{path: "color"},
+		{path: "sound"},
+		{path: "duration_seconds"},
+		{path: "coordinates"},
+		// Обязательные поля можно только заменить
+		{path: "location", wantErr: true},
+		{path: "description", wantErr: true},
+		{path: "location", updateInfo: &ufoV1.SightingUpdateInfo{Location: wrapperspb.String("Алматы")}},
+		{path: "description", updateInfo: &ufoV1.SightingUpdateInfo{Description: wrapperspb.String("диск")}},
+		{path: "info.color", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			err := checkUpdateMask(&fieldmaskpb.FieldMask{Paths: []string{tt.path}}, tt.updateInfo)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// validationStatus приводит ошибку проверки к InvalidArgument с деталями errdetails.BadRequest.
+// parent - поле запроса, внутри которого лежат проверенные данные (info, update_info, infos),
+// чтобы пути в нарушениях совпадали с путями в запросе.
+func validationStatus(err error, parent string) (error, bool) {
+	var verr *model.ValidationError
+	if !errors.As(err, &verr) {
+		return nil, false
+	}
+	verr = verr.Nested(parent)
+
+	st, detailsErr := status.New(codes.InvalidArgument, verr.Error()).WithDetails(converter.ValidationErrorToBadRequest(verr))
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, verr.Error()), true
+	}
+
+	return st.Err(), true
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/converter"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (a *api) WatchSightings(req *ufoV1.WatchSightingsRequest, stream ufoV1.UFOService_WatchSightingsServer) error {
+	ctx := stream.Context()
+
+	events, err := a.ufoService.Watch(ctx, req.GetResumeToken())
+	if err != nil {
+		return watchStatus(err)
+	}
+	defer func() {
+		// Контекст стрима к этому моменту может быть уже отменен
+		cerr := events.Close(context.WithoutCancel(ctx))
+		if cerr != nil {
+			logger.Error(ctx, "failed to close sighting event stream", zap.Error(cerr))
+		}
+	}()
+
+	for {
+		event, err := events.Next(ctx)
+		if err != nil {
+			return watchStatus(err)
+		}
+
+		err = stream.Send(converter.SightingEventToProto(event))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// watchStatus приводит ошибку подписки к gRPC статусу
+func watchStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidResumeToken):
+		return status.Error(codes.InvalidArgument, "invalid resume token")
+	case errors.Is(err, model.ErrResumeTokenExpired):
+		return status.Error(codes.OutOfRange, "resume token expired, reload sightings and watch without resume token")
+	case errors.Is(err, model.ErrWatchNotSupported):
+		return status.Error(codes.Unimplemented, "watching sightings is not supported by the configured storage")
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return err
+	}
+}
@@ -4,17 +4,30 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/admin"
 	"github.com/baizhigit/go-ms-examples/di/platform/pkg/closer"
 	"github.com/baizhigit/go-ms-examples/di/platform/pkg/grpc/health"
+	grpcInterceptor "github.com/baizhigit/go-ms-examples/di/platform/pkg/grpc/interceptor"
 	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/tracing"
 	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/config"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/interceptor"
+)
+
+// Доли общего времени на shutdown, чтобы после HEALTH_DRAIN_DELAY, остановки сервера и релея
+// осталось время закрыть хранилища
+const (
+	grpcDrainTimeout   = 2 * time.Second
+	adminDrainTimeout  = time.Second
+	outboxFlushTimeout = time.Second
 )
 
 type App struct {
@@ -43,7 +56,10 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initTracing,
 		a.initListener,
+		a.initOutboxRelay,
+		a.initAdminServer,
 		a.initGRPCServer,
 	}
 
@@ -74,12 +90,30 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+// initTracing настраивает OpenTelemetry до создания клиентов БД и gRPC сервера, чтобы их
+// инструментация получила глобальный провайдер. Провайдер регистрируется в closer раньше
+// релея и поэтому сбрасывает spans после его остановки
+func (a *App) initTracing(ctx context.Context) error {
+	cfg := config.AppConfig().Tracing
+
+	return tracing.Init(ctx, tracing.Options{
+		ServiceName:  cfg.ServiceName(),
+		Exporter:     cfg.Exporter(),
+		OTLPEndpoint: cfg.OTLPEndpoint(),
+		OTLPInsecure: cfg.OTLPInsecure(),
+		SampleRatio:  cfg.SampleRatio(),
+		StdoutFile:   cfg.StdoutFile(),
+	})
+}
+
 func (a *App) initListener(_ context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().UFOGRPC.Address())
 	if err != nil {
 		return err
 	}
-	closer.AddNamed("TCP listener", func(ctx context.Context) error {
+	// Listener закрывается в той же фазе, что и gRPC сервер, но после него: GracefulStop
+	// сам закрывает listener, а раннее закрытие завершило бы Serve с ошибкой
+	closer.AddPhase(closer.PhaseDrain, "TCP listener", 0, func(ctx context.Context) error {
 		lerr := listener.Close()
 		if lerr != nil && !errors.Is(lerr, net.ErrClosed) {
 			return lerr
@@ -93,23 +127,75 @@ func (a *App) initListener(_ context.Context) error {
 	return nil
 }
 
+// initOutboxRelay запускает публикацию событий outbox. Релей останавливается в фазе flush,
+// после gRPC сервера: события последних запросов успевают уйти.
+func (a *App) initOutboxRelay(ctx context.Context) error {
+	relay := a.diContainer.OutboxRelay(ctx)
+	relay.Start()
+	closer.AddPhase(closer.PhaseFlush, "outbox relay", outboxFlushTimeout, relay.Stop)
+
+	return nil
+}
+
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
-	closer.AddNamed("gRPC server", func(ctx context.Context) error {
-		a.grpcServer.GracefulStop()
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		// Span запроса создается до перехватчиков, и trace_id в логах совпадает с трассой
+		grpc.StatsHandler(tracing.ServerHandler()),
+		// trace_id и user_id из метаданных попадают в контекст первыми, чтобы все логи запроса были с ними
+		// Purge доступен только с токеном администратора
+		grpc.ChainUnaryInterceptor(
+			grpcInterceptor.UnaryServerTrace(),
+			interceptor.UnaryAdmin(config.AppConfig().UFOGRPC.AdminToken(), ufoV1.UFOService_Purge_FullMethodName),
+			interceptor.UnaryActor(),
+		),
+		grpc.ChainStreamInterceptor(grpcInterceptor.StreamServerTrace(), interceptor.StreamActor()),
+	)
+	closer.AddPhase(closer.PhaseDrain, "gRPC server", grpcDrainTimeout, func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			a.grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		// Подписки WatchSightings не завершаются сами, поэтому по истечении
+		// времени на shutdown оставшиеся стримы обрываются принудительно
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			a.grpcServer.Stop()
+		}
+
 		return nil
 	})
 
 	reflection.Register(a.grpcServer)
 
-	// Регистрируем health service для проверки работоспособности
-	health.RegisterService(a.grpcServer)
-
 	ufoV1.RegisterUFOServiceServer(a.grpcServer, a.diContainer.UfoV1API(ctx))
 
+	// Health service отвечает по пробам зависимостей, которые добавились при создании API,
+	// и переходит в NOT_SERVING в начале graceful shutdown
+	healthServer := a.diContainer.HealthServer(ctx)
+	health.RegisterService(a.grpcServer, healthServer)
+	healthServer.Start()
+
 	return nil
 }
 
+// initAdminServer запускает служебный HTTP сервер (/healthz, /readyz, /metrics, /loglevel) рядом с gRPC.
+// /readyz отвечает по тому же health registry, что и grpc.health.v1.Health. Сервер запускается раньше
+// gRPC и поэтому останавливается после него: во время drain /readyz продолжает отвечать 503
+func (a *App) initAdminServer(ctx context.Context) error {
+	cfg := config.AppConfig().AdminHTTP
+
+	return admin.NewServer(admin.Options{
+		Address:         cfg.Address(),
+		EnablePprof:     cfg.PprofEnabled(),
+		Token:           cfg.Token(),
+		ShutdownTimeout: adminDrainTimeout,
+	}, a.diContainer.HealthServer(ctx)).Start(ctx)
+}
+
 func (a *App) runGRPCServer(ctx context.Context) error {
 	logger.Info(ctx, fmt.Sprintf("🚀 gRPC InventoryService server listening on %s", config.AppConfig().UFOGRPC.Address()))
 
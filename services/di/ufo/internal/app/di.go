@@ -3,39 +3,87 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 
 	"github.com/baizhigit/go-ms-examples/di/platform/pkg/closer"
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/grpc/health"
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/migrator"
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/tracing"
 	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
 	ufoV1API "github.com/baizhigit/go-ms-examples/di/ufo/internal/api/ufo/v1"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/config"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/outbox"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/publisher"
+	memoryPublisher "github.com/baizhigit/go-ms-examples/di/ufo/internal/publisher/memory"
+	writerPublisher "github.com/baizhigit/go-ms-examples/di/ufo/internal/publisher/writer"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+	filesystemStorage "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/filesystem"
+	gridfsStorage "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/gridfs"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+	postgresRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/postgres"
 	ufoRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/ufo"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/service"
+	attachmentService "github.com/baizhigit/go-ms-examples/di/ufo/internal/service/attachment"
 	ufoService "github.com/baizhigit/go-ms-examples/di/ufo/internal/service/ufo"
 )
 
+// memoryPublisherCapacity сколько последних событий хранит OUTBOX_PUBLISHER=memory
+const memoryPublisherCapacity = 1024
+
+// ufoServiceName имя сервиса, под которым health отдает состояние зависимостей UFOService
+var ufoServiceName = ufoV1.UFOService_ServiceDesc.ServiceName
+
 type diContainer struct {
 	ufoV1API ufoV1.UFOServiceServer
 
-	ufoService service.UFOService
+	ufoService        service.UFOService
+	attachmentService service.AttachmentService
 
-	ufoRepository repository.UFORepository
+	ufoRepository     repository.UFORepository
+	outboxRepository  repository.OutboxRepository
+	attachmentStorage repository.AttachmentStorage
+
+	eventPublisher publisher.EventPublisher
+	outboxRelay    *outbox.Relay
 
 	mongoDBClient *mongo.Client
 	mongoDBHandle *mongo.Database
+
+	postgresPool *pgxpool.Pool
+
+	healthServer *health.Server
 }
 
 func NewDiContainer() *diContainer {
 	return &diContainer{}
 }
 
+// HealthServer собирает пробы зависимостей: каждая зависимость добавляет свою пробу при создании
+func (d *diContainer) HealthServer(_ context.Context) *health.Server {
+	if d.healthServer == nil {
+		cfg := config.AppConfig().Health
+		d.healthServer = health.NewServer(health.Options{
+			CheckInterval: cfg.CheckInterval(),
+			ProbeTimeout:  cfg.ProbeTimeout(),
+			DrainDelay:    cfg.DrainDelay(),
+		})
+		d.healthServer.AddService(ufoServiceName)
+	}
+
+	return d.healthServer
+}
+
 func (d *diContainer) UfoV1API(ctx context.Context) ufoV1.UFOServiceServer {
 	if d.ufoV1API == nil {
-		d.ufoV1API = ufoV1API.NewAPI(d.PartService(ctx))
+		d.ufoV1API = ufoV1API.NewAPI(d.PartService(ctx), d.AttachmentService(ctx))
 	}
 
 	return d.ufoV1API
@@ -43,23 +91,130 @@ func (d *diContainer) UfoV1API(ctx context.Context) ufoV1.UFOServiceServer {
 
 func (d *diContainer) PartService(ctx context.Context) service.UFOService {
 	if d.ufoService == nil {
-		d.ufoService = ufoService.NewService(d.PartRepository(ctx))
+		d.ufoService = ufoService.NewService(
+			d.PartRepository(ctx),
+			d.AttachmentStorage(ctx),
+			config.AppConfig().UFOService.BatchMaxSize(),
+			config.AppConfig().UFOService.IdempotencyKeyTTL(),
+			config.AppConfig().UFOService.DuplicateWindow(),
+		)
 	}
 
 	return d.ufoService
 }
 
+func (d *diContainer) AttachmentService(ctx context.Context) service.AttachmentService {
+	if d.attachmentService == nil {
+		d.attachmentService = attachmentService.NewService(
+			d.PartRepository(ctx),
+			d.AttachmentStorage(ctx),
+			config.AppConfig().Attachment.MaxSizeBytes(),
+		)
+	}
+
+	return d.attachmentService
+}
+
 func (d *diContainer) PartRepository(ctx context.Context) repository.UFORepository {
 	if d.ufoRepository == nil {
-		d.ufoRepository = ufoRepository.NewRepository(d.MongoDBHandle(ctx))
+		// Outbox хранится рядом с наблюдениями, поэтому обе роли исполняет один репозиторий
+		switch config.AppConfig().Storage.Driver() {
+		case config.StorageDriverPostgres:
+			repo := postgresRepository.NewRepository(d.PostgresPool(ctx))
+			d.ufoRepository, d.outboxRepository = repo, repo
+			logger.Warn(ctx, "⚠️ WatchSightings is not supported by PostgreSQL storage and returns UNIMPLEMENTED")
+		case config.StorageDriverMemory:
+			repo := memoryRepository.NewRepository()
+			d.ufoRepository, d.outboxRepository = repo, repo
+		default:
+			repo := ufoRepository.NewRepository(d.MongoDBHandle(ctx))
+			d.ufoRepository, d.outboxRepository = repo, repo
+		}
 	}
 
 	return d.ufoRepository
 }
 
+func (d *diContainer) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if d.outboxRepository == nil {
+		d.PartRepository(ctx)
+	}
+
+	return d.outboxRepository
+}
+
+func (d *diContainer) EventPublisher(_ context.Context) publisher.EventPublisher {
+	if d.eventPublisher == nil {
+		switch config.AppConfig().Outbox.Publisher() {
+		case config.OutboxPublisherFile:
+			path := config.AppConfig().Outbox.File()
+
+			err := os.MkdirAll(filepath.Dir(path), 0o755)
+			if err != nil {
+				panic(fmt.Sprintf("failed to create outbox file directory: %v\n", err))
+			}
+
+			file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+			if err != nil {
+				panic(fmt.Sprintf("failed to open outbox file: %v\n", err))
+			}
+
+			closer.AddNamed("outbox file", func(ctx context.Context) error {
+				return file.Close()
+			})
+
+			d.eventPublisher = writerPublisher.NewPublisher(file)
+		case config.OutboxPublisherMemory:
+			d.eventPublisher = memoryPublisher.NewPublisher(memoryPublisherCapacity)
+		default:
+			d.eventPublisher = writerPublisher.NewPublisher(os.Stdout)
+		}
+	}
+
+	return d.eventPublisher
+}
+
+func (d *diContainer) OutboxRelay(ctx context.Context) *outbox.Relay {
+	if d.outboxRelay == nil {
+		cfg := config.AppConfig().Outbox
+		d.outboxRelay = outbox.NewRelay(d.OutboxRepository(ctx), d.EventPublisher(ctx), outbox.Options{
+			PollInterval:   cfg.PollInterval(),
+			BatchSize:      cfg.BatchSize(),
+			RetryBaseDelay: cfg.RetryBaseDelay(),
+			RetryMaxDelay:  cfg.RetryMaxDelay(),
+		})
+
+		d.HealthServer(ctx).AddProbe(ufoServiceName, "outbox relay", d.outboxRelay.Check)
+	}
+
+	return d.outboxRelay
+}
+
+// AttachmentStorage хранит содержимое вложений рядом с наблюдениями: в GridFS той же базы MongoDB,
+// для остальных хранилищ - в локальном каталоге
+func (d *diContainer) AttachmentStorage(ctx context.Context) repository.AttachmentStorage {
+	if d.attachmentStorage == nil {
+		switch config.AppConfig().Storage.Driver() {
+		case config.StorageDriverPostgres, config.StorageDriverMemory:
+			storage, err := filesystemStorage.NewStorage(config.AppConfig().Attachment.Dir())
+			if err != nil {
+				panic(fmt.Sprintf("failed to init attachment storage: %v\n", err))
+			}
+			d.attachmentStorage = storage
+		default:
+			d.attachmentStorage = gridfsStorage.NewStorage(d.MongoDBHandle(ctx))
+		}
+	}
+
+	return d.attachmentStorage
+}
+
 func (d *diContainer) MongoDBClient(ctx context.Context) *mongo.Client {
 	if d.mongoDBClient == nil {
-		client, err := mongo.Connect(options.Client().ApplyURI(config.AppConfig().Mongo.URI()))
+		// Монитор создает span на каждую команду, дочерний к span запроса из контекста
+		client, err := mongo.Connect(options.Client().
+			ApplyURI(config.AppConfig().Mongo.URI()).
+			SetMonitor(tracing.MongoMonitor()))
 		if err != nil {
 			panic(fmt.Sprintf("failed to connect to MongoDB: %s\n", err.Error()))
 		}
@@ -73,6 +228,10 @@ func (d *diContainer) MongoDBClient(ctx context.Context) *mongo.Client {
 			return client.Disconnect(ctx)
 		})
 
+		d.HealthServer(ctx).AddProbe(ufoServiceName, "MongoDB", func(ctx context.Context) error {
+			return client.Ping(ctx, readpref.Primary())
+		})
+
 		d.mongoDBClient = client
 	}
 
@@ -86,3 +245,40 @@ func (d *diContainer) MongoDBHandle(ctx context.Context) *mongo.Database {
 
 	return d.mongoDBHandle
 }
+
+func (d *diContainer) PostgresPool(ctx context.Context) *pgxpool.Pool {
+	if d.postgresPool == nil {
+		pool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
+		if err != nil {
+			panic(fmt.Sprintf("failed to connect to PostgreSQL: %s\n", err.Error()))
+		}
+
+		err = pool.Ping(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to ping PostgreSQL: %v\n", err))
+		}
+
+		closer.AddNamed("PostgreSQL pool", func(ctx context.Context) error {
+			pool.Close()
+			return nil
+		})
+
+		d.HealthServer(ctx).AddProbe(ufoServiceName, "PostgreSQL", pool.Ping)
+
+		// Схема должна быть актуальной до первого обращения репозитория к базе
+		db := stdlib.OpenDBFromPool(pool)
+		err = migrator.NewMigrator(db, config.AppConfig().Postgres.MigrationsDir()).Up()
+		if err != nil {
+			panic(fmt.Sprintf("failed to migrate PostgreSQL: %v\n", err))
+		}
+
+		err = db.Close()
+		if err != nil {
+			panic(fmt.Sprintf("failed to close migration connection: %v\n", err))
+		}
+
+		d.postgresPool = pool
+	}
+
+	return d.postgresPool
+}
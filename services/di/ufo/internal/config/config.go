@@ -1,19 +1,43 @@
 package config
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/joho/godotenv"
 
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/tracing"
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/config/env"
 )
 
+// Поддерживаемые значения STORAGE_DRIVER
+const (
+	StorageDriverMongo    = "mongo"
+	StorageDriverPostgres = "postgres"
+	StorageDriverMemory   = "memory"
+)
+
+// Поддерживаемые значения OUTBOX_PUBLISHER
+const (
+	OutboxPublisherStdout = "stdout"
+	OutboxPublisherFile   = "file"
+	OutboxPublisherMemory = "memory"
+)
+
 var appConfig *config
 
 type config struct {
-	Logger  LoggerConfig
-	UFOGRPC UFOGRPCConfig
-	Mongo   MongoConfig
+	Logger     LoggerConfig
+	UFOGRPC    UFOGRPCConfig
+	AdminHTTP  AdminHTTPConfig
+	UFOService UFOServiceConfig
+	Storage    StorageConfig
+	Attachment AttachmentConfig
+	Outbox     OutboxConfig
+	Health     HealthConfig
+	Tracing    TracingConfig
+	Mongo      MongoConfig
+	Postgres   PostgresConfig
 }
 
 func Load(path ...string) error {
@@ -32,15 +56,146 @@ func Load(path ...string) error {
 		return err
 	}
 
-	mongoCfg, err := env.NewMongoConfig()
+	adminHTTPCfg, err := env.NewAdminHTTPConfig()
+	if err != nil {
+		return err
+	}
+
+	ufoServiceCfg, err := env.NewUFOServiceConfig()
+	if err != nil {
+		return err
+	}
+
+	if ufoServiceCfg.BatchMaxSize() <= 0 {
+		return fmt.Errorf("BATCH_MAX_SIZE must be positive, got %d", ufoServiceCfg.BatchMaxSize())
+	}
+
+	if ufoServiceCfg.IdempotencyKeyTTL() <= 0 {
+		return fmt.Errorf("IDEMPOTENCY_KEY_TTL must be positive, got %s", ufoServiceCfg.IdempotencyKeyTTL())
+	}
+
+	if ufoServiceCfg.DuplicateWindow() < 0 {
+		return fmt.Errorf("DUPLICATE_WINDOW must not be negative, got %s", ufoServiceCfg.DuplicateWindow())
+	}
+
+	storageCfg, err := env.NewStorageConfig()
 	if err != nil {
 		return err
 	}
 
-	appConfig = &config{
-		Logger:  loggerCfg,
-		UFOGRPC: ufoGRPCCfg,
-		Mongo:   mongoCfg,
+	attachmentCfg, err := env.NewAttachmentConfig()
+	if err != nil {
+		return err
+	}
+
+	if attachmentCfg.MaxSizeBytes() <= 0 {
+		return fmt.Errorf("ATTACHMENT_MAX_SIZE_BYTES must be positive, got %d", attachmentCfg.MaxSizeBytes())
+	}
+
+	outboxCfg, err := env.NewOutboxConfig()
+	if err != nil {
+		return err
+	}
+
+	err = validateOutboxConfig(outboxCfg)
+	if err != nil {
+		return err
+	}
+
+	healthCfg, err := env.NewHealthConfig()
+	if err != nil {
+		return err
+	}
+
+	if healthCfg.CheckInterval() <= 0 || healthCfg.ProbeTimeout() <= 0 {
+		return fmt.Errorf("HEALTH_CHECK_INTERVAL and HEALTH_PROBE_TIMEOUT must be positive, got %s and %s",
+			healthCfg.CheckInterval(), healthCfg.ProbeTimeout())
+	}
+
+	if healthCfg.DrainDelay() < 0 {
+		return fmt.Errorf("HEALTH_DRAIN_DELAY must not be negative, got %s", healthCfg.DrainDelay())
+	}
+
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
+	err = validateTracingConfig(tracingCfg)
+	if err != nil {
+		return err
+	}
+
+	cfg := &config{
+		Logger:     loggerCfg,
+		UFOGRPC:    ufoGRPCCfg,
+		AdminHTTP:  adminHTTPCfg,
+		UFOService: ufoServiceCfg,
+		Storage:    storageCfg,
+		Attachment: attachmentCfg,
+		Outbox:     outboxCfg,
+		Health:     healthCfg,
+		Tracing:    tracingCfg,
+	}
+
+	// Настройки читаются только для выбранного хранилища,
+	// чтобы не требовать переменные окружения неиспользуемой БД
+	switch storageCfg.Driver() {
+	case StorageDriverMongo:
+		mongoCfg, err := env.NewMongoConfig()
+		if err != nil {
+			return err
+		}
+		cfg.Mongo = mongoCfg
+	case StorageDriverPostgres:
+		postgresCfg, err := env.NewPostgresConfig()
+		if err != nil {
+			return err
+		}
+		cfg.Postgres = postgresCfg
+	case StorageDriverMemory:
+		// Хранилище в памяти процесса не требует настроек
+	default:
+		return fmt.Errorf("unknown STORAGE_DRIVER %q", storageCfg.Driver())
+	}
+
+	appConfig = cfg
+
+	return nil
+}
+
+func validateOutboxConfig(cfg OutboxConfig) error {
+	switch cfg.Publisher() {
+	case OutboxPublisherStdout, OutboxPublisherFile, OutboxPublisherMemory:
+	default:
+		return fmt.Errorf("unknown OUTBOX_PUBLISHER %q", cfg.Publisher())
+	}
+
+	if cfg.PollInterval() <= 0 {
+		return fmt.Errorf("OUTBOX_POLL_INTERVAL must be positive, got %s", cfg.PollInterval())
+	}
+
+	if cfg.BatchSize() <= 0 {
+		return fmt.Errorf("OUTBOX_BATCH_SIZE must be positive, got %d", cfg.BatchSize())
+	}
+
+	if cfg.RetryBaseDelay() <= 0 || cfg.RetryMaxDelay() < cfg.RetryBaseDelay() {
+		return fmt.Errorf("OUTBOX_RETRY_BASE_DELAY must be positive and not greater than OUTBOX_RETRY_MAX_DELAY, got %s and %s",
+			cfg.RetryBaseDelay(), cfg.RetryMaxDelay())
+	}
+
+	return nil
+}
+
+func validateTracingConfig(cfg TracingConfig) error {
+	switch cfg.Exporter() {
+	case tracing.ExporterOTLP, tracing.ExporterStdout, tracing.ExporterNone:
+	default:
+		return fmt.Errorf("unknown TRACING_EXPORTER %q", cfg.Exporter())
+	}
+
+	if cfg.SampleRatio() < 0 || cfg.SampleRatio() > 1 {
+		return fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1, got %g", cfg.SampleRatio())
 	}
 
 	return nil
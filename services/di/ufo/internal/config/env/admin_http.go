@@ -0,0 +1,39 @@
+package env
+
+import (
+	"net"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type adminHTTPEnvConfig struct {
+	Host         string `env:"ADMIN_HTTP_HOST" envDefault:"localhost"`
+	Port         string `env:"ADMIN_HTTP_PORT" envDefault:"8081"`
+	PprofEnabled bool   `env:"ADMIN_PPROF_ENABLED" envDefault:"false"`
+	Token        string `env:"ADMIN_HTTP_TOKEN"`
+}
+
+type adminHTTPConfig struct {
+	raw adminHTTPEnvConfig
+}
+
+func NewAdminHTTPConfig() (*adminHTTPConfig, error) {
+	var raw adminHTTPEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &adminHTTPConfig{raw: raw}, nil
+}
+
+func (cfg *adminHTTPConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
+
+func (cfg *adminHTTPConfig) PprofEnabled() bool {
+	return cfg.raw.PprofEnabled
+}
+
+func (cfg *adminHTTPConfig) Token() string {
+	return cfg.raw.Token
+}
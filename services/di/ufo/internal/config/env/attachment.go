@@ -0,0 +1,31 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type attachmentEnvConfig struct {
+	MaxSizeBytes int64  `env:"ATTACHMENT_MAX_SIZE_BYTES" envDefault:"52428800"`
+	Dir          string `env:"ATTACHMENT_DIR" envDefault:"./data/attachments"`
+}
+
+type attachmentConfig struct {
+	raw attachmentEnvConfig
+}
+
+func NewAttachmentConfig() (*attachmentConfig, error) {
+	var raw attachmentEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &attachmentConfig{raw: raw}, nil
+}
+
+func (cfg *attachmentConfig) MaxSizeBytes() int64 {
+	return cfg.raw.MaxSizeBytes
+}
+
+func (cfg *attachmentConfig) Dir() string {
+	return cfg.raw.Dir
+}
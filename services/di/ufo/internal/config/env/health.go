@@ -0,0 +1,38 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type healthEnvConfig struct {
+	CheckInterval time.Duration `env:"HEALTH_CHECK_INTERVAL" envDefault:"5s"`
+	ProbeTimeout  time.Duration `env:"HEALTH_PROBE_TIMEOUT" envDefault:"1s"`
+	DrainDelay    time.Duration `env:"HEALTH_DRAIN_DELAY" envDefault:"1s"`
+}
+
+type healthConfig struct {
+	raw healthEnvConfig
+}
+
+func NewHealthConfig() (*healthConfig, error) {
+	var raw healthEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &healthConfig{raw: raw}, nil
+}
+
+func (cfg *healthConfig) CheckInterval() time.Duration {
+	return cfg.raw.CheckInterval
+}
+
+func (cfg *healthConfig) ProbeTimeout() time.Duration {
+	return cfg.raw.ProbeTimeout
+}
+
+func (cfg *healthConfig) DrainDelay() time.Duration {
+	return cfg.raw.DrainDelay
+}
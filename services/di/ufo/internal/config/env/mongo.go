@@ -13,6 +13,8 @@ type mongoEnvConfig struct {
 	User     string `env:"MONGO_INITDB_ROOT_USERNAME,required"`
 	Password string `env:"MONGO_INITDB_ROOT_PASSWORD,required"`
 	AuthDB   string `env:"MONGO_AUTH_DB,required"`
+	// ReplicaSet имя replica set; пустое - подключение к одиночному mongod
+	ReplicaSet string `env:"MONGO_REPLICA_SET"`
 }
 
 type mongoConfig struct {
@@ -30,7 +32,7 @@ func NewMongoConfig() (*mongoConfig, error) {
 }
 
 func (cfg *mongoConfig) URI() string {
-	return fmt.Sprintf(
+	uri := fmt.Sprintf(
 		"mongodb://%s:%s@%s:%s/%s?authSource=%s",
 		cfg.raw.User,
 		cfg.raw.Password,
@@ -39,6 +41,11 @@ func (cfg *mongoConfig) URI() string {
 		cfg.raw.Database,
 		cfg.raw.AuthDB,
 	)
+	if cfg.raw.ReplicaSet != "" {
+		uri += "&replicaSet=" + cfg.raw.ReplicaSet
+	}
+
+	return uri
 }
 
 func (cfg *mongoConfig) DatabaseName() string {
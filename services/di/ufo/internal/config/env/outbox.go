@@ -0,0 +1,53 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type outboxEnvConfig struct {
+	Publisher      string        `env:"OUTBOX_PUBLISHER" envDefault:"stdout"`
+	File           string        `env:"OUTBOX_FILE" envDefault:"./data/outbox.ndjson"`
+	PollInterval   time.Duration `env:"OUTBOX_POLL_INTERVAL" envDefault:"1s"`
+	BatchSize      int           `env:"OUTBOX_BATCH_SIZE" envDefault:"100"`
+	RetryBaseDelay time.Duration `env:"OUTBOX_RETRY_BASE_DELAY" envDefault:"1s"`
+	RetryMaxDelay  time.Duration `env:"OUTBOX_RETRY_MAX_DELAY" envDefault:"5m"`
+}
+
+type outboxConfig struct {
+	raw outboxEnvConfig
+}
+
+func NewOutboxConfig() (*outboxConfig, error) {
+	var raw outboxEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &outboxConfig{raw: raw}, nil
+}
+
+func (cfg *outboxConfig) Publisher() string {
+	return cfg.raw.Publisher
+}
+
+func (cfg *outboxConfig) File() string {
+	return cfg.raw.File
+}
+
+func (cfg *outboxConfig) PollInterval() time.Duration {
+	return cfg.raw.PollInterval
+}
+
+func (cfg *outboxConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
+
+func (cfg *outboxConfig) RetryBaseDelay() time.Duration {
+	return cfg.raw.RetryBaseDelay
+}
+
+func (cfg *outboxConfig) RetryMaxDelay() time.Duration {
+	return cfg.raw.RetryMaxDelay
+}
@@ -0,0 +1,49 @@
+package env
+
+import (
+	"net"
+	"net/url"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type postgresEnvConfig struct {
+	Host          string `env:"POSTGRES_HOST,required"`
+	Port          string `env:"POSTGRES_PORT,required"`
+	Database      string `env:"POSTGRES_DB,required"`
+	User          string `env:"POSTGRES_USER,required"`
+	Password      string `env:"POSTGRES_PASSWORD,required"`
+	SSLMode       string `env:"POSTGRES_SSL_MODE" envDefault:"disable"`
+	MigrationsDir string `env:"MIGRATIONS_DIR,required"`
+}
+
+type postgresConfig struct {
+	raw postgresEnvConfig
+}
+
+func NewPostgresConfig() (*postgresConfig, error) {
+	var raw postgresEnvConfig
+	err := env.Parse(&raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgresConfig{raw: raw}, nil
+}
+
+// URI собирается через url.URL: пароль с @, :, /, ? или # экранируется, а не ломает адрес
+func (cfg *postgresConfig) URI() string {
+	uri := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.raw.User, cfg.raw.Password),
+		Host:     net.JoinHostPort(cfg.raw.Host, cfg.raw.Port),
+		Path:     cfg.raw.Database,
+		RawQuery: url.Values{"sslmode": {cfg.raw.SSLMode}}.Encode(),
+	}
+
+	return uri.String()
+}
+
+func (cfg *postgresConfig) MigrationsDir() string {
+	return cfg.raw.MigrationsDir
+}
@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type storageEnvConfig struct {
+	Driver string `env:"STORAGE_DRIVER" envDefault:"mongo"`
+}
+
+type storageConfig struct {
+	raw storageEnvConfig
+}
+
+func NewStorageConfig() (*storageConfig, error) {
+	var raw storageEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &storageConfig{raw: raw}, nil
+}
+
+func (cfg *storageConfig) Driver() string {
+	return cfg.raw.Driver
+}
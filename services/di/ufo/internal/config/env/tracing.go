@@ -0,0 +1,51 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type tracingEnvConfig struct {
+	Exporter     string  `env:"TRACING_EXPORTER" envDefault:"none"`
+	ServiceName  string  `env:"TRACING_SERVICE_NAME" envDefault:"ufo"`
+	OTLPEndpoint string  `env:"TRACING_OTLP_ENDPOINT" envDefault:"localhost:4317"`
+	OTLPInsecure bool    `env:"TRACING_OTLP_INSECURE" envDefault:"true"`
+	SampleRatio  float64 `env:"TRACING_SAMPLE_RATIO" envDefault:"1"`
+	StdoutFile   string  `env:"TRACING_STDOUT_FILE"`
+}
+
+type tracingConfig struct {
+	raw tracingEnvConfig
+}
+
+func NewTracingConfig() (*tracingConfig, error) {
+	var raw tracingEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &tracingConfig{raw: raw}, nil
+}
+
+func (cfg *tracingConfig) Exporter() string {
+	return cfg.raw.Exporter
+}
+
+func (cfg *tracingConfig) ServiceName() string {
+	return cfg.raw.ServiceName
+}
+
+func (cfg *tracingConfig) OTLPEndpoint() string {
+	return cfg.raw.OTLPEndpoint
+}
+
+func (cfg *tracingConfig) OTLPInsecure() bool {
+	return cfg.raw.OTLPInsecure
+}
+
+func (cfg *tracingConfig) SampleRatio() float64 {
+	return cfg.raw.SampleRatio
+}
+
+func (cfg *tracingConfig) StdoutFile() string {
+	return cfg.raw.StdoutFile
+}
@@ -9,6 +9,8 @@ import (
 type ufoGRPCEnvConfig struct {
 	Host string `env:"GRPC_HOST,required"`
 	Port string `env:"GRPC_PORT,required"`
+	// AdminToken пустой отключает административные методы
+	AdminToken string `env:"GRPC_ADMIN_TOKEN"`
 }
 
 type ufoGRPCConfig struct {
@@ -27,3 +29,7 @@ func NewUFOGRPCConfig() (*ufoGRPCConfig, error) {
 func (cfg *ufoGRPCConfig) Address() string {
 	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
 }
+
+func (cfg *ufoGRPCConfig) AdminToken() string {
+	return cfg.raw.AdminToken
+}
@@ -0,0 +1,38 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type ufoServiceEnvConfig struct {
+	BatchMaxSize      int           `env:"BATCH_MAX_SIZE" envDefault:"100"`
+	IdempotencyKeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	DuplicateWindow   time.Duration `env:"DUPLICATE_WINDOW" envDefault:"1h"`
+}
+
+type ufoServiceConfig struct {
+	raw ufoServiceEnvConfig
+}
+
+func NewUFOServiceConfig() (*ufoServiceConfig, error) {
+	var raw ufoServiceEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &ufoServiceConfig{raw: raw}, nil
+}
+
+func (cfg *ufoServiceConfig) BatchMaxSize() int {
+	return cfg.raw.BatchMaxSize
+}
+
+func (cfg *ufoServiceConfig) IdempotencyKeyTTL() time.Duration {
+	return cfg.raw.IdempotencyKeyTTL
+}
+
+func (cfg *ufoServiceConfig) DuplicateWindow() time.Duration {
+	return cfg.raw.DuplicateWindow
+}
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
@@ -7,9 +9,65 @@ type LoggerConfig interface {
 
 type UFOGRPCConfig interface {
 	Address() string
+	// AdminToken токен для административных методов (Purge), пустой - методы отключены
+	AdminToken() string
+}
+
+type AdminHTTPConfig interface {
+	Address() string
+	PprofEnabled() bool
+	// Token заголовка X-Admin-Token для PUT /loglevel и pprof; пустой отключает их
+	Token() string
+}
+
+type UFOServiceConfig interface {
+	BatchMaxSize() int
+	IdempotencyKeyTTL() time.Duration
+	// DuplicateWindow насколько могут различаться времена наблюдений одного события, 0 - не искать дубликаты
+	DuplicateWindow() time.Duration
 }
 
 type MongoConfig interface {
 	URI() string
 	DatabaseName() string
 }
+
+type StorageConfig interface {
+	Driver() string
+}
+
+type AttachmentConfig interface {
+	MaxSizeBytes() int64
+	Dir() string
+}
+
+type PostgresConfig interface {
+	URI() string
+	MigrationsDir() string
+}
+
+type HealthConfig interface {
+	CheckInterval() time.Duration
+	ProbeTimeout() time.Duration
+	DrainDelay() time.Duration
+}
+
+type TracingConfig interface {
+	// Exporter otlp, stdout или none
+	Exporter() string
+	ServiceName() string
+	OTLPEndpoint() string
+	OTLPInsecure() bool
+	SampleRatio() float64
+	// StdoutFile файл spans для экспортера stdout; пустой - stderr
+	StdoutFile() string
+}
+
+type OutboxConfig interface {
+	Publisher() string
+	File() string
+	PollInterval() time.Duration
+	BatchSize() int
+	RetryBaseDelay() time.Duration
+	RetryMaxDelay() time.Duration
+}
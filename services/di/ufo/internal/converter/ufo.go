@@ -1,8 +1,11 @@
 package converter
 
 import (
+	"slices"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -42,6 +45,7 @@ func UFOInfoToModel(info *ufoV1.SightingInfo) model.SightingInfo {
 		Color:           color,
 		Sound:           sound,
 		DurationSeconds: durationSeconds,
+		Coordinates:     GeoPointToModel(info.Coordinates),
 	}
 }
 
@@ -89,9 +93,63 @@ func UpdateInfoToModel(info *ufoV1.SightingUpdateInfo) model.SightingUpdateInfo
 		Color:           color,
 		Sound:           sound,
 		DurationSeconds: durationSeconds,
+		Coordinates:     GeoPointToModel(info.Coordinates),
 	}
 }
 
+// UpdateRequestToModel учитывает update_mask: поле из маски, не заданное в update_info, очищается,
+// поля вне маски игнорируются. Маска должна быть проверена заранее: обязательные location и
+// description в маске всегда заданы.
+func UpdateRequestToModel(req *ufoV1.UpdateRequest) model.SightingUpdateInfo {
+	var updateInfo model.SightingUpdateInfo
+	if req.GetUpdateInfo() != nil {
+		updateInfo = UpdateInfoToModel(req.GetUpdateInfo())
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return updateInfo
+	}
+
+	masked := model.SightingUpdateInfo{}
+	// Повторы в маске не должны очищать поле дважды
+	for _, path := range slices.Compact(slices.Sorted(slices.Values(paths))) {
+		switch path {
+		case "observed_at":
+			masked.ObservedAt = updateInfo.ObservedAt
+			if masked.ObservedAt == nil {
+				masked.Clear = append(masked.Clear, model.SightingFieldObservedAt)
+			}
+		case "location":
+			masked.Location = updateInfo.Location
+		case "description":
+			masked.Description = updateInfo.Description
+		case "color":
+			masked.Color = updateInfo.Color
+			if masked.Color == nil {
+				masked.Clear = append(masked.Clear, model.SightingFieldColor)
+			}
+		case "sound":
+			masked.Sound = updateInfo.Sound
+			if masked.Sound == nil {
+				masked.Clear = append(masked.Clear, model.SightingFieldSound)
+			}
+		case "duration_seconds":
+			masked.DurationSeconds = updateInfo.DurationSeconds
+			if masked.DurationSeconds == nil {
+				masked.Clear = append(masked.Clear, model.SightingFieldDurationSeconds)
+			}
+		case "coordinates":
+			masked.Coordinates = updateInfo.Coordinates
+			if masked.Coordinates == nil {
+				masked.Clear = append(masked.Clear, model.SightingFieldCoordinates)
+			}
+		}
+	}
+
+	return masked
+}
+
 func SightingToProto(sighting model.Sighting) *ufoV1.Sighting {
 	var updatedAt *timestamppb.Timestamp
 	if sighting.UpdatedAt != nil {
@@ -103,12 +161,19 @@ func SightingToProto(sighting model.Sighting) *ufoV1.Sighting {
 		deletedAt = timestamppb.New(*sighting.DeletedAt)
 	}
 
+	var attachments []*ufoV1.Attachment
+	for _, attachment := range sighting.Attachments {
+		attachments = append(attachments, AttachmentToProto(attachment))
+	}
+
 	return &ufoV1.Sighting{
-		Uuid:      sighting.Uuid,
-		Info:      SightingInfoToProto(sighting.Info),
-		CreatedAt: timestamppb.New(sighting.CreatedAt),
-		UpdatedAt: updatedAt,
-		DeletedAt: deletedAt,
+		Uuid:        sighting.Uuid,
+		Info:        SightingInfoToProto(sighting.Info),
+		CreatedAt:   timestamppb.New(sighting.CreatedAt),
+		UpdatedAt:   updatedAt,
+		DeletedAt:   deletedAt,
+		Version:     sighting.Version,
+		Attachments: attachments,
 	}
 }
 
@@ -140,5 +205,414 @@ func SightingInfoToProto(info model.SightingInfo) *ufoV1.SightingInfo {
 		Color:           color,
 		Sound:           sound,
 		DurationSeconds: durationSeconds,
+		Coordinates:     GeoPointToProto(info.Coordinates),
+	}
+}
+
+func GeoPointToModel(point *ufoV1.GeoPoint) *model.GeoPoint {
+	if point == nil {
+		return nil
+	}
+
+	return &model.GeoPoint{
+		Latitude:  point.Latitude,
+		Longitude: point.Longitude,
+	}
+}
+
+func GeoPointToProto(point *model.GeoPoint) *ufoV1.GeoPoint {
+	if point == nil {
+		return nil
+	}
+
+	return &ufoV1.GeoPoint{
+		Latitude:  point.Latitude,
+		Longitude: point.Longitude,
+	}
+}
+
+func ListRequestToModel(req *ufoV1.ListRequest) model.SightingListQuery {
+	return model.SightingListQuery{
+		Filter:    SightingFilterToModel(req.GetFilter()),
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+func SightingFilterToModel(filter *ufoV1.SightingFilter) model.SightingFilter {
+	if filter == nil {
+		return model.SightingFilter{}
+	}
+
+	var observedFrom *time.Time
+	if filter.ObservedFrom != nil {
+		tmp := filter.ObservedFrom.AsTime()
+		observedFrom = &tmp
+	}
+
+	var observedTo *time.Time
+	if filter.ObservedTo != nil {
+		tmp := filter.ObservedTo.AsTime()
+		observedTo = &tmp
+	}
+
+	var location *string
+	if filter.Location != nil {
+		tmp := filter.Location.Value
+		location = &tmp
+	}
+
+	var color *string
+	if filter.Color != nil {
+		tmp := filter.Color.Value
+		color = &tmp
+	}
+
+	var sound *bool
+	if filter.Sound != nil {
+		tmp := filter.Sound.Value
+		sound = &tmp
+	}
+
+	return model.SightingFilter{
+		ObservedFrom:   observedFrom,
+		ObservedTo:     observedTo,
+		Location:       location,
+		Color:          color,
+		Sound:          sound,
+		IncludeDeleted: filter.IncludeDeleted,
+	}
+}
+
+func SightingListToProto(list model.SightingList) *ufoV1.ListResponse {
+	sightings := make([]*ufoV1.Sighting, 0, len(list.Sightings))
+	for _, sighting := range list.Sightings {
+		sightings = append(sightings, SightingToProto(sighting))
+	}
+
+	return &ufoV1.ListResponse{
+		Sightings:     sightings,
+		NextPageToken: list.NextPageToken,
+	}
+}
+
+func ExpectedVersionToModel(expectedVersion *wrapperspb.Int64Value) *int64 {
+	if expectedVersion == nil {
+		return nil
+	}
+
+	tmp := expectedVersion.Value
+	return &tmp
+}
+
+func SightingEventToProto(event model.SightingEvent) *ufoV1.SightingEvent {
+	var sighting *ufoV1.Sighting
+	if event.Sighting != nil {
+		sighting = SightingToProto(*event.Sighting)
+	}
+
+	return &ufoV1.SightingEvent{
+		Type:        SightingEventTypeToProto(event.Type),
+		Uuid:        event.Uuid,
+		Sighting:    sighting,
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+		ResumeToken: event.ResumeToken,
+	}
+}
+
+func SightingEventTypeToProto(eventType model.SightingEventType) ufoV1.SightingEventType {
+	switch eventType {
+	case model.SightingEventTypeCreated:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_CREATED
+	case model.SightingEventTypeUpdated:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_UPDATED
+	case model.SightingEventTypeDeleted:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_DELETED
+	case model.SightingEventTypeRestored:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_RESTORED
+	case model.SightingEventTypePurged:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_PURGED
+	default:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func BatchCreateResultsToProto(results []model.SightingCreateResult) *ufoV1.BatchCreateResponse {
+	protoResults := make([]*ufoV1.BatchCreateResult, 0, len(results))
+	for _, result := range results {
+		if result.Err != nil {
+			protoResults = append(protoResults, &ufoV1.BatchCreateResult{
+				Result: &ufoV1.BatchCreateResult_Error{Error: result.Err.Error()},
+			})
+			continue
+		}
+
+		protoResults = append(protoResults, &ufoV1.BatchCreateResult{
+			Result: &ufoV1.BatchCreateResult_Uuid{Uuid: result.Uuid},
+		})
+	}
+
+	return &ufoV1.BatchCreateResponse{
+		Results: protoResults,
+	}
+}
+
+func SightingBatchToProto(batch model.SightingBatch) *ufoV1.BatchGetResponse {
+	sightings := make([]*ufoV1.Sighting, 0, len(batch.Sightings))
+	for _, sighting := range batch.Sightings {
+		sightings = append(sightings, SightingToProto(sighting))
+	}
+
+	return &ufoV1.BatchGetResponse{
+		Sightings:    sightings,
+		MissingUuids: batch.MissingUuids,
 	}
 }
+
+func ImportSummaryToProto(summary model.ImportSummary) *ufoV1.ImportSightingsResponse {
+	rejections := make([]*ufoV1.ImportRejection, 0, len(summary.Rejections))
+	for _, rejection := range summary.Rejections {
+		rejections = append(rejections, &ufoV1.ImportRejection{
+			Index:  rejection.Index,
+			Reason: rejection.Reason,
+		})
+	}
+
+	return &ufoV1.ImportSightingsResponse{
+		ReceivedCount: summary.ReceivedCount,
+		InsertedCount: summary.InsertedCount,
+		RejectedCount: summary.RejectedCount,
+		Rejections:    rejections,
+		Duration:      durationpb.New(summary.Duration),
+	}
+}
+
+func SearchHitsToProto(hits []model.SightingSearchHit) *ufoV1.SearchResponse {
+	protoHits := make([]*ufoV1.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		highlights := make([]*ufoV1.SearchHighlight, 0, len(hit.Highlights))
+		for _, highlight := range hit.Highlights {
+			highlights = append(highlights, &ufoV1.SearchHighlight{
+				Field:    highlight.Field,
+				Fragment: highlight.Fragment,
+			})
+		}
+
+		protoHits = append(protoHits, &ufoV1.SearchHit{
+			Sighting:   SightingToProto(hit.Sighting),
+			Score:      hit.Score,
+			Highlights: highlights,
+		})
+	}
+
+	return &ufoV1.SearchResponse{
+		Hits: protoHits,
+	}
+}
+
+func NearbySightingsToProto(sightings []model.NearbySighting) *ufoV1.FindNearbyResponse {
+	protoSightings := make([]*ufoV1.NearbySighting, 0, len(sightings))
+	for _, sighting := range sightings {
+		protoSightings = append(protoSightings, &ufoV1.NearbySighting{
+			Sighting:       SightingToProto(sighting.Sighting),
+			DistanceMeters: sighting.DistanceMeters,
+		})
+	}
+
+	return &ufoV1.FindNearbyResponse{
+		Sightings: protoSightings,
+	}
+}
+
+func StatsRequestToModel(req *ufoV1.GetStatsRequest) model.StatsQuery {
+	var observedFrom *time.Time
+	if req.ObservedFrom != nil {
+		tmp := req.ObservedFrom.AsTime()
+		observedFrom = &tmp
+	}
+
+	var observedTo *time.Time
+	if req.ObservedTo != nil {
+		tmp := req.ObservedTo.AsTime()
+		observedTo = &tmp
+	}
+
+	return model.StatsQuery{
+		ObservedFrom: observedFrom,
+		ObservedTo:   observedTo,
+		GroupBy:      StatsGroupByToModel(req.GroupBy),
+	}
+}
+
+func StatsGroupByToModel(groupBy ufoV1.StatsGroupBy) model.StatsGroupBy {
+	switch groupBy {
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_DAY:
+		return model.StatsGroupByDay
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_WEEK:
+		return model.StatsGroupByWeek
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_MONTH:
+		return model.StatsGroupByMonth
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_COLOR:
+		return model.StatsGroupByColor
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_SOUND:
+		return model.StatsGroupBySound
+	default:
+		return model.StatsGroupByUnspecified
+	}
+}
+
+func StatsGroupByToProto(groupBy model.StatsGroupBy) ufoV1.StatsGroupBy {
+	switch groupBy {
+	case model.StatsGroupByDay:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_DAY
+	case model.StatsGroupByWeek:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_WEEK
+	case model.StatsGroupByMonth:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_MONTH
+	case model.StatsGroupByColor:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_COLOR
+	case model.StatsGroupBySound:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_SOUND
+	default:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_UNSPECIFIED
+	}
+}
+
+func StatsToProto(stats model.Stats) *ufoV1.GetStatsResponse {
+	buckets := make([]*ufoV1.StatsBucket, 0, len(stats.Buckets))
+	for _, bucket := range stats.Buckets {
+		var start *timestamppb.Timestamp
+		if bucket.Start != nil {
+			start = timestamppb.New(*bucket.Start)
+		}
+
+		buckets = append(buckets, &ufoV1.StatsBucket{
+			Key:   bucket.Key,
+			Start: start,
+			Count: bucket.Count,
+		})
+	}
+
+	return &ufoV1.GetStatsResponse{
+		GroupBy: StatsGroupByToProto(stats.GroupBy),
+		Buckets: buckets,
+		Total:   stats.Total,
+	}
+}
+
+func AttachmentToProto(attachment model.Attachment) *ufoV1.Attachment {
+	return &ufoV1.Attachment{
+		Id:          attachment.Id,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		Sha256:      attachment.Sha256,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+func HistoryRequestToModel(req *ufoV1.GetHistoryRequest) model.SightingHistoryQuery {
+	var asOf *time.Time
+	if req.GetAsOf() != nil {
+		t := req.GetAsOf().AsTime()
+		asOf = &t
+	}
+
+	return model.SightingHistoryQuery{
+		Uuid: req.GetUuid(),
+		AsOf: asOf,
+	}
+}
+
+func HistoryToProto(history model.SightingHistory) *ufoV1.GetHistoryResponse {
+	revisions := make([]*ufoV1.SightingRevision, 0, len(history.Revisions))
+	for _, revision := range history.Revisions {
+		revisions = append(revisions, RevisionToProto(revision))
+	}
+
+	var asOf *ufoV1.Sighting
+	if history.AsOf != nil {
+		asOf = SightingToProto(*history.AsOf)
+	}
+
+	return &ufoV1.GetHistoryResponse{
+		Revisions:    revisions,
+		AsOfSighting: asOf,
+	}
+}
+
+func RevisionToProto(revision model.SightingRevision) *ufoV1.SightingRevision {
+	changes := make([]*ufoV1.FieldChange, 0, len(revision.Changes))
+	for _, change := range revision.Changes {
+		var oldValue, newValue *wrapperspb.StringValue
+		if change.OldValue != nil {
+			oldValue = wrapperspb.String(*change.OldValue)
+		}
+		if change.NewValue != nil {
+			newValue = wrapperspb.String(*change.NewValue)
+		}
+
+		changes = append(changes, &ufoV1.FieldChange{
+			Field:    change.Field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	return &ufoV1.SightingRevision{
+		SightingUuid: revision.SightingUuid,
+		Version:      revision.Version,
+		Action:       RevisionActionToProto(revision.Action),
+		Actor:        revision.Actor,
+		OccurredAt:   timestamppb.New(revision.OccurredAt),
+		Changes:      changes,
+		Sighting:     SightingToProto(revision.Sighting),
+	}
+}
+
+func RevisionActionToProto(action model.RevisionAction) ufoV1.RevisionAction {
+	switch action {
+	case model.RevisionActionCreated:
+		return ufoV1.RevisionAction_REVISION_ACTION_CREATED
+	case model.RevisionActionUpdated:
+		return ufoV1.RevisionAction_REVISION_ACTION_UPDATED
+	case model.RevisionActionDeleted:
+		return ufoV1.RevisionAction_REVISION_ACTION_DELETED
+	case model.RevisionActionRestored:
+		return ufoV1.RevisionAction_REVISION_ACTION_RESTORED
+	default:
+		return ufoV1.RevisionAction_REVISION_ACTION_UNSPECIFIED
+	}
+}
+
+func CreatedSightingToProto(created model.CreatedSighting) *ufoV1.CreateResponse {
+	duplicates := make([]*ufoV1.Sighting, 0, len(created.PossibleDuplicates))
+	for _, sighting := range created.PossibleDuplicates {
+		duplicates = append(duplicates, SightingToProto(sighting))
+	}
+
+	return &ufoV1.CreateResponse{
+		Uuid:               created.Uuid,
+		PossibleDuplicates: duplicates,
+	}
+}
+
+func MergeRequestToModel(req *ufoV1.MergeSightingsRequest) model.SightingMerge {
+	return model.SightingMerge{
+		TargetUuid:      req.GetTargetUuid(),
+		DuplicateUuids:  req.GetDuplicateUuids(),
+		ExpectedVersion: ExpectedVersionToModel(req.GetExpectedVersion()),
+	}
+}
+
+func ValidationErrorToBadRequest(verr *model.ValidationError) *errdetails.BadRequest {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(verr.Violations))
+	for _, violation := range verr.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	return &errdetails.BadRequest{FieldViolations: violations}
+}
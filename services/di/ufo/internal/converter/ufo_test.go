@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/di/shared/pkg/proto/ufo/v1"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func TestUpdateRequestToModelWithoutMask(t *testing.T) {
+	updateInfo := UpdateRequestToModel(&ufoV1.UpdateRequest{
+		UpdateInfo: &ufoV1.SightingUpdateInfo{
+			Color: wrapperspb.String("синий"),
+		},
+	})
+
+	require.Equal(t, model.SightingUpdateInfo{Color: ptr("синий")}, updateInfo)
+}
+
+func TestUpdateRequestToModelWithMask(t *testing.T) {
+	updateInfo := UpdateRequestToModel(&ufoV1.UpdateRequest{
+		UpdateInfo: &ufoV1.SightingUpdateInfo{
+			Color:       wrapperspb.String("синий"),
+			Location:    wrapperspb.String("Алматы"),
+			Description: wrapperspb.String("вне маски"),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"color", "sound", "location", "sound"}},
+	})
+
+	// Поля из маски без значения очищаются, поля вне маски игнорируются
+	require.Equal(t, model.SightingUpdateInfo{
+		Color:    ptr("синий"),
+		Location: ptr("Алматы"),
+		Clear:    []model.SightingField{model.SightingFieldSound},
+	}, updateInfo)
+}
+
+func TestUpdateRequestToModelMaskWithoutInfo(t *testing.T) {
+	updateInfo := UpdateRequestToModel(&ufoV1.UpdateRequest{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"coordinates", "observed_at"}},
+	})
+
+	require.Equal(t, model.SightingUpdateInfo{
+		Clear: []model.SightingField{model.SightingFieldCoordinates, model.SightingFieldObservedAt},
+	}, updateInfo)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,34 @@
+// Package geo считает расстояния между точками на поверхности Земли для FindNearby.
+package geo
+
+import (
+	"math"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// EarthRadiusMeters радиус Земли, с которым MongoDB считает сферические расстояния;
+// остальные хранилища используют его же, чтобы расстояния не зависели от выбора хранилища
+const EarthRadiusMeters = 6378100.0
+
+// Valid проверяет, что широта и долгота в допустимых пределах
+func Valid(point model.GeoPoint) bool {
+	return point.Latitude >= -90 && point.Latitude <= 90 &&
+		point.Longitude >= -180 && point.Longitude <= 180
+}
+
+// Distance возвращает расстояние между точками в метрах по формуле гаверсинусов
+func Distance(a, b model.GeoPoint) float64 {
+	lat1 := radians(a.Latitude)
+	lat2 := radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLng := radians(b.Longitude - a.Longitude)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+
+	return 2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,32 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func TestDistance(t *testing.T) {
+	almaty := model.GeoPoint{Latitude: 43.2389, Longitude: 76.8897}
+	astana := model.GeoPoint{Latitude: 51.1694, Longitude: 71.4491}
+
+	require.Zero(t, Distance(almaty, almaty))
+	require.InDelta(t, 970_000, Distance(almaty, astana), 10_000)
+	require.InDelta(t, Distance(almaty, astana), Distance(astana, almaty), 1e-6)
+
+	// Половина экватора
+	require.InDelta(t, math.Pi*EarthRadiusMeters, Distance(
+		model.GeoPoint{Latitude: 0, Longitude: 0},
+		model.GeoPoint{Latitude: 0, Longitude: 180},
+	), 1)
+}
+
+func TestValid(t *testing.T) {
+	require.True(t, Valid(model.GeoPoint{Latitude: 90, Longitude: -180}))
+	require.False(t, Valid(model.GeoPoint{Latitude: 91, Longitude: 0}))
+	require.False(t, Valid(model.GeoPoint{Latitude: 0, Longitude: 180.5}))
+	require.False(t, Valid(model.GeoPoint{Latitude: math.NaN(), Longitude: 0}))
+}
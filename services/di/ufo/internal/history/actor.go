@@ -0,0 +1,16 @@
+package history
+
+import "context"
+
+type actorKey struct{}
+
+// WithActor сохраняет в контексте, кто выполняет запрос; попадает в ревизии наблюдений
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor возвращает автора изменений из контекста, пустую строку, если он неизвестен
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
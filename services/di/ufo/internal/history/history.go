@@ -0,0 +1,141 @@
+// Package history описывает изменения наблюдений одинаково для всех хранилищ:
+// какие поля изменились в ревизии и каким было наблюдение на заданный момент.
+package history
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// field поле SightingInfo, участвующее в сравнении; value возвращает nil для незаполненного поля
+type field struct {
+	name  string
+	value func(info model.SightingInfo) *string
+}
+
+// fields поля в порядке SightingInfo, имена совпадают с именами полей proto
+var fields = []field{
+	{name: "observed_at", value: func(info model.SightingInfo) *string {
+		if info.ObservedAt == nil {
+			return nil
+		}
+		return ptr(info.ObservedAt.UTC().Format(time.RFC3339Nano))
+	}},
+	{name: "location", value: func(info model.SightingInfo) *string {
+		return nonEmpty(info.Location)
+	}},
+	{name: "description", value: func(info model.SightingInfo) *string {
+		return nonEmpty(info.Description)
+	}},
+	{name: "color", value: func(info model.SightingInfo) *string {
+		return info.Color
+	}},
+	{name: "sound", value: func(info model.SightingInfo) *string {
+		if info.Sound == nil {
+			return nil
+		}
+		return ptr(strconv.FormatBool(*info.Sound))
+	}},
+	{name: "duration_seconds", value: func(info model.SightingInfo) *string {
+		if info.DurationSeconds == nil {
+			return nil
+		}
+		return ptr(strconv.FormatInt(int64(*info.DurationSeconds), 10))
+	}},
+	{name: "coordinates", value: func(info model.SightingInfo) *string {
+		if info.Coordinates == nil {
+			return nil
+		}
+		return ptr(strconv.FormatFloat(info.Coordinates.Latitude, 'f', -1, 64) + "," +
+			strconv.FormatFloat(info.Coordinates.Longitude, 'f', -1, 64))
+	}},
+}
+
+// Diff возвращает поля, значения которых отличаются в before и after.
+// При before == nil (создание) возвращаются все заполненные поля after.
+func Diff(before *model.SightingInfo, after model.SightingInfo) []model.FieldChange {
+	var changes []model.FieldChange
+	for _, f := range fields {
+		var oldValue *string
+		if before != nil {
+			oldValue = f.value(*before)
+		}
+		newValue := f.value(after)
+
+		if equal(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, model.FieldChange{
+			Field:    f.name,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	return changes
+}
+
+// NewRevision описывает изменение наблюдения, после которого оно стало after.
+// before - данные до изменения, nil для создания. Автор изменения берется из ctx.
+func NewRevision(ctx context.Context, action model.RevisionAction, before *model.SightingInfo, after model.Sighting, occurredAt time.Time) model.SightingRevision {
+	var changes []model.FieldChange
+	switch action {
+	case model.RevisionActionCreated:
+		changes = Diff(nil, after.Info)
+	case model.RevisionActionUpdated:
+		changes = Diff(before, after.Info)
+	default:
+		// Удаление и восстановление не меняют данные наблюдения
+	}
+
+	// Снимок содержит метаданные вложений, но не их содержимое: оно не версионируется
+	return model.SightingRevision{
+		SightingUuid: after.Uuid,
+		Version:      after.Version,
+		Action:       action,
+		Actor:        Actor(ctx),
+		OccurredAt:   occurredAt,
+		Changes:      changes,
+		Sighting:     after,
+	}
+}
+
+// AsOf возвращает состояние наблюдения на момент at по ревизиям, упорядоченным по версии.
+// Второе значение false, если на этот момент ревизий еще не было.
+func AsOf(revisions []model.SightingRevision, at time.Time) (model.Sighting, bool) {
+	var (
+		sighting model.Sighting
+		found    bool
+	)
+	for _, revision := range revisions {
+		if revision.OccurredAt.After(at) {
+			break
+		}
+		sighting, found = revision.Sighting, true
+	}
+
+	return sighting, found
+}
+
+func equal(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
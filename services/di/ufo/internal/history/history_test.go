@@ -0,0 +1,94 @@
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func TestDiffCreated(t *testing.T) {
+	observedAt := time.Date(2024, 6, 12, 23, 30, 0, 0, time.FixedZone("UTC+5", 5*60*60))
+
+	changes := Diff(nil, model.SightingInfo{
+		ObservedAt:  &observedAt,
+		Location:    "Алматы",
+		Sound:       ptr(false),
+		Coordinates: &model.GeoPoint{Latitude: 43.2389, Longitude: 76.8897},
+	})
+
+	require.Equal(t, []model.FieldChange{
+		{Field: "observed_at", NewValue: ptr("2024-06-12T18:30:00Z")},
+		{Field: "location", NewValue: ptr("Алматы")},
+		{Field: "sound", NewValue: ptr("false")},
+		{Field: "coordinates", NewValue: ptr("43.2389,76.8897")},
+	}, changes)
+}
+
+func TestDiffUpdated(t *testing.T) {
+	before := model.SightingInfo{
+		Location:        "Алматы",
+		Description:     "Светящийся шар",
+		Color:           ptr("зеленый"),
+		DurationSeconds: ptr(int32(60)),
+	}
+	after := before
+	after.Description = "Светящийся треугольник"
+	after.DurationSeconds = ptr(int32(90))
+
+	require.Equal(t, []model.FieldChange{
+		{Field: "description", OldValue: ptr("Светящийся шар"), NewValue: ptr("Светящийся треугольник")},
+		{Field: "duration_seconds", OldValue: ptr("60"), NewValue: ptr("90")},
+	}, Diff(&before, after))
+
+	require.Empty(t, Diff(&before, before))
+}
+
+func TestNewRevision(t *testing.T) {
+	ctx := WithActor(context.Background(), "witness-42")
+	now := time.Now()
+
+	before := model.SightingInfo{Location: "Алматы", Description: "Шар"}
+	after := model.Sighting{
+		Uuid:        "some-uuid",
+		Info:        model.SightingInfo{Location: "Алматы", Description: "Треугольник"},
+		Version:     3,
+		Attachments: []model.Attachment{{Id: "photo"}},
+	}
+
+	revision := NewRevision(ctx, model.RevisionActionUpdated, &before, after, now)
+	require.Equal(t, "some-uuid", revision.SightingUuid)
+	require.EqualValues(t, 3, revision.Version)
+	require.Equal(t, "witness-42", revision.Actor)
+	require.Equal(t, now, revision.OccurredAt)
+	require.Len(t, revision.Changes, 1)
+	require.Equal(t, after.Attachments, revision.Sighting.Attachments)
+
+	// Удаление не меняет данные, даже если передать прежнее состояние
+	deleted := NewRevision(context.Background(), model.RevisionActionDeleted, &before, after, now)
+	require.Empty(t, deleted.Changes)
+	require.Empty(t, deleted.Actor)
+}
+
+func TestAsOf(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	revisions := []model.SightingRevision{
+		{Version: 1, OccurredAt: start, Sighting: model.Sighting{Version: 1}},
+		{Version: 2, OccurredAt: start.Add(time.Hour), Sighting: model.Sighting{Version: 2}},
+		{Version: 3, OccurredAt: start.Add(2 * time.Hour), Sighting: model.Sighting{Version: 3}},
+	}
+
+	_, ok := AsOf(revisions, start.Add(-time.Second))
+	require.False(t, ok)
+
+	sighting, ok := AsOf(revisions, start.Add(time.Hour))
+	require.True(t, ok)
+	require.EqualValues(t, 2, sighting.Version)
+
+	sighting, ok = AsOf(revisions, start.Add(24*time.Hour))
+	require.True(t, ok)
+	require.EqualValues(t, 3, sighting.Version)
+}
@@ -0,0 +1,49 @@
+// Package interceptor содержит gRPC-перехватчики сервиса.
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	grpcInterceptor "github.com/baizhigit/go-ms-examples/di/platform/pkg/grpc/interceptor"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/history"
+)
+
+// ActorHeader - заголовок метаданных, из которого берется автор изменения;
+// тот же заголовок дает user_id в логах
+const ActorHeader = grpcInterceptor.UserIDHeader
+
+// UnaryActor кладет автора из метаданных запроса в контекст, чтобы
+// репозиторий записал его в ревизию
+func UnaryActor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withActor(ctx), req)
+	}
+}
+
+// StreamActor - то же для стримов (ImportSightings создает наблюдения)
+func StreamActor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &actorStream{ServerStream: ss, ctx: withActor(ss.Context())})
+	}
+}
+
+type actorStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *actorStream) Context() context.Context {
+	return s.ctx
+}
+
+func withActor(ctx context.Context) context.Context {
+	values := metadata.ValueFromIncomingContext(ctx, ActorHeader)
+	if len(values) == 0 || values[0] == "" {
+		return ctx
+	}
+
+	return history.WithActor(ctx, values[0])
+}
@@ -0,0 +1,34 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/history"
+)
+
+func TestUnaryActor(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "witness-42"))
+
+	var actor string
+	_, err := UnaryActor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+		actor = history.Actor(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "witness-42", actor)
+}
+
+func TestUnaryActorWithoutHeader(t *testing.T) {
+	var actor string
+	_, err := UnaryActor()(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+		actor = history.Actor(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Empty(t, actor)
+}
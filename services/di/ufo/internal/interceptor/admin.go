@@ -0,0 +1,44 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AdminHeader - заголовок метаданных с токеном для административных методов
+const AdminHeader = "x-admin-token"
+
+// UnaryAdmin пропускает вызовы перечисленных методов только с токеном token в заголовке
+// x-admin-token. Пустой token отключает эти методы совсем; остальные методы не проверяются
+func UnaryAdmin(token string, methods ...string) grpc.UnaryServerInterceptor {
+	admin := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		admin[method] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := admin[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		if token == "" {
+			return nil, status.Error(codes.PermissionDenied, "admin methods are disabled")
+		}
+
+		values := metadata.ValueFromIncomingContext(ctx, AdminHeader)
+		if len(values) == 0 {
+			return nil, status.Errorf(codes.Unauthenticated, "%s is required", AdminHeader)
+		}
+
+		if subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) != 1 {
+			return nil, status.Errorf(codes.PermissionDenied, "invalid %s", AdminHeader)
+		}
+
+		return handler(ctx, req)
+	}
+}
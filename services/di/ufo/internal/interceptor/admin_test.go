@@ -0,0 +1,44 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryAdmin(t *testing.T) {
+	const purge = "/ufo.v1.UFOService/Purge"
+
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		md     metadata.MD
+		code   codes.Code
+	}{
+		{name: "valid token", token: "secret", method: purge, md: metadata.Pairs(AdminHeader, "secret"), code: codes.OK},
+		{name: "missing token", token: "secret", method: purge, md: metadata.MD{}, code: codes.Unauthenticated},
+		{name: "wrong token", token: "secret", method: purge, md: metadata.Pairs(AdminHeader, "guess"), code: codes.PermissionDenied},
+		{name: "disabled", token: "", method: purge, md: metadata.Pairs(AdminHeader, ""), code: codes.PermissionDenied},
+		{name: "regular method", token: "secret", method: "/ufo.v1.UFOService/Get", md: metadata.MD{}, code: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			called := false
+			_, err := UnaryAdmin(tt.token, purge)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(context.Context, any) (any, error) {
+					called = true
+					return nil, nil
+				})
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.code == codes.OK, called)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type Attachment struct {
+	Id          string
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	// Sha256 контрольная сумма содержимого в шестнадцатеричном виде
+	Sha256    string
+	CreatedAt time.Time
+}
+
+type AttachmentUpload struct {
+	SightingUuid string
+	FileName     string
+}
@@ -2,4 +2,45 @@ package model
 
 import "errors"
 
-var ErrSightingNotFound = errors.New("sighting not found")
+var (
+	ErrSightingNotFound   = errors.New("sighting not found")
+	ErrSightingDeleted    = errors.New("sighting deleted")
+	ErrSightingNotDeleted = errors.New("sighting not deleted")
+	ErrVersionConflict    = errors.New("sighting version conflict")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	ErrResumeTokenExpired = errors.New("resume token expired")
+	ErrWatchNotSupported  = errors.New("watching sightings is not supported by storage")
+
+	ErrEmptyBatch    = errors.New("batch is empty")
+	ErrBatchTooLarge = errors.New("batch is too large")
+	// ErrSightingNotCreated элемент пакета не сохранен; причина от хранилища пишется только в лог
+	ErrSightingNotCreated = errors.New("sighting was not created")
+
+	ErrInvalidImportItem = errors.New("invalid import item")
+
+	ErrEmptySearchQuery = errors.New("search query is empty")
+
+	// ErrInvalidSighting данные наблюдения не прошли проверку, подробности - в ValidationError
+	ErrInvalidSighting    = errors.New("invalid sighting")
+	ErrInvalidCoordinates = errors.New("invalid coordinates")
+	ErrInvalidRadius      = errors.New("invalid radius")
+
+	ErrInvalidStatsGroupBy = errors.New("invalid stats grouping")
+	ErrInvalidStatsRange   = errors.New("invalid stats range")
+	ErrStatsRangeTooLarge  = errors.New("stats range is too large")
+
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrInvalidAttachmentUpload   = errors.New("invalid attachment upload")
+	ErrEmptyAttachment           = errors.New("attachment is empty")
+	ErrAttachmentTooLarge        = errors.New("attachment is too large")
+	ErrUnsupportedAttachmentType = errors.New("unsupported attachment type")
+
+	ErrNoSightingAsOf = errors.New("sighting did not exist at the requested time")
+
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key reused with a different request")
+
+	ErrInvalidMerge = errors.New("invalid merge")
+)
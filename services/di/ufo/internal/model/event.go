@@ -0,0 +1,34 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+type SightingEventType int
+
+const (
+	SightingEventTypeUnspecified SightingEventType = iota
+	SightingEventTypeCreated
+	SightingEventTypeUpdated
+	SightingEventTypeDeleted
+	SightingEventTypeRestored
+	SightingEventTypePurged
+)
+
+type SightingEvent struct {
+	Type SightingEventType
+	Uuid string
+	// Sighting состояние после изменения, nil для SightingEventTypePurged
+	Sighting   *Sighting
+	OccurredAt time.Time
+	// ResumeToken позволяет продолжить подписку сразу после этого события
+	ResumeToken string
+}
+
+// SightingEventStream поток событий изменения наблюдений.
+// Next блокируется до следующего события, отмены ctx или ошибки источника.
+type SightingEventStream interface {
+	Next(ctx context.Context) (SightingEvent, error)
+	Close(ctx context.Context) error
+}
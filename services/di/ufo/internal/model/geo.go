@@ -0,0 +1,18 @@
+package model
+
+// GeoPoint точка на поверхности Земли в градусах (WGS 84)
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+type NearbyQuery struct {
+	Center       GeoPoint
+	RadiusMeters float64
+	Limit        int32
+}
+
+type NearbySighting struct {
+	Sighting       Sighting
+	DistanceMeters float64
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// IdempotencyKey ключ идемпотентности Create и результат первого запроса с ним
+type IdempotencyKey struct {
+	Key string
+	// RequestHash отпечаток данных запроса: повтор ключа с другими данными отклоняется
+	RequestHash string
+	// Uuid наблюдения, созданного первым запросом
+	Uuid      string
+	ExpiresAt time.Time
+}
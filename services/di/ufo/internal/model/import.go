@@ -0,0 +1,29 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// SightingInfoSource источник импортируемых наблюдений.
+// Next возвращает io.EOF, когда источник исчерпан, и ошибку, обернутую в
+// ErrInvalidImportItem, если конкретный элемент не может быть импортирован.
+type SightingInfoSource interface {
+	Next(ctx context.Context) (SightingInfo, error)
+}
+
+// ImportRejection элемент импорта, который не удалось сохранить
+type ImportRejection struct {
+	// Index порядковый номер элемента в источнике, начиная с 0
+	Index  int64
+	Reason string
+}
+
+type ImportSummary struct {
+	ReceivedCount int64
+	InsertedCount int64
+	RejectedCount int64
+	// Rejections первые отказы, полное количество в RejectedCount
+	Rejections []ImportRejection
+	Duration   time.Duration
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// OutboxEvent событие об изменении наблюдения, записанное в outbox
+// вместе с самим изменением и ожидающее публикации
+type OutboxEvent struct {
+	Id           string
+	Type         SightingEventType
+	SightingUuid string
+	Version      int64
+	// Payload снимок наблюдения после изменения в JSON
+	Payload    []byte
+	OccurredAt time.Time
+	// Attempts число неудачных попыток публикации
+	Attempts int
+}
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+type RevisionAction int
+
+const (
+	RevisionActionUnspecified RevisionAction = iota
+	RevisionActionCreated
+	RevisionActionUpdated
+	RevisionActionDeleted
+	RevisionActionRestored
+)
+
+// FieldChange изменение одного поля наблюдения. Значения в текстовом виде, nil - поле не заполнено
+type FieldChange struct {
+	Field    string
+	OldValue *string
+	NewValue *string
+}
+
+// SightingRevision неизменяемая запись об одном изменении наблюдения
+type SightingRevision struct {
+	SightingUuid string
+	// Version версия наблюдения после изменения
+	Version int64
+	Action  RevisionAction
+	// Actor кто внес изменение, пустая строка - неизвестно
+	Actor      string
+	OccurredAt time.Time
+	Changes    []FieldChange
+	// Sighting состояние наблюдения после изменения; у вложений только метаданные
+	Sighting Sighting
+}
+
+type SightingHistoryQuery struct {
+	Uuid string
+	AsOf *time.Time
+}
+
+type SightingHistory struct {
+	// Revisions ревизии по возрастанию версии
+	Revisions []SightingRevision
+	// AsOf состояние наблюдения на момент SightingHistoryQuery.AsOf, nil, если момент не запрошен
+	AsOf *Sighting
+}
@@ -11,8 +11,21 @@ type SightingInfo struct {
 	Color           *string
 	Sound           *bool
 	DurationSeconds *int32
+	Coordinates     *GeoPoint
 }
 
+// SightingField необязательное поле наблюдения, которое Update может очистить
+type SightingField string
+
+const (
+	SightingFieldObservedAt      SightingField = "observed_at"
+	SightingFieldColor           SightingField = "color"
+	SightingFieldSound           SightingField = "sound"
+	SightingFieldDurationSeconds SightingField = "duration_seconds"
+	SightingFieldCoordinates     SightingField = "coordinates"
+)
+
+// SightingUpdateInfo изменения наблюдения: заданные поля устанавливаются, поля из Clear очищаются
 type SightingUpdateInfo struct {
 	ObservedAt      *time.Time
 	Location        *string
@@ -20,6 +33,9 @@ type SightingUpdateInfo struct {
 	Color           *string
 	Sound           *bool
 	DurationSeconds *int32
+	Coordinates     *GeoPoint
+	// Clear поля, которые нужно очистить; поле не может быть одновременно задано и очищено
+	Clear []SightingField
 }
 
 type Sighting struct {
@@ -28,4 +44,63 @@ type Sighting struct {
 	CreatedAt time.Time
 	UpdatedAt *time.Time
 	DeletedAt *time.Time
+	Version   int64
+	// Attachments вложения в порядке загрузки
+	Attachments []Attachment
+}
+
+type SightingFilter struct {
+	ObservedFrom   *time.Time
+	ObservedTo     *time.Time
+	Location       *string
+	Color          *string
+	Sound          *bool
+	IncludeDeleted bool
+}
+
+type SightingListQuery struct {
+	Filter    SightingFilter
+	PageSize  int32
+	PageToken string
+}
+
+type SightingList struct {
+	Sightings     []Sighting
+	NextPageToken string
+}
+
+// CreatedSighting результат Create: UUID нового наблюдения и ранее созданные
+// наблюдения, которые похожи на него и, вероятно, описывают то же событие
+type CreatedSighting struct {
+	Uuid               string
+	PossibleDuplicates []Sighting
+}
+
+// SightingMerge запрос на объединение дубликатов с основным наблюдением
+type SightingMerge struct {
+	TargetUuid     string
+	DuplicateUuids []string
+	// ExpectedVersion ожидаемая версия основного наблюдения, nil - любая
+	ExpectedVersion *int64
+}
+
+// SightingCreateResult результат создания одного наблюдения из пакета:
+// заполнен либо Uuid, либо Err
+type SightingCreateResult struct {
+	Uuid string
+	Err  error
+}
+
+// PurgeResult итог окончательного удаления
+type PurgeResult struct {
+	PurgedCount int64
+	// AttachmentIds вложения удаленных наблюдений: их содержимое больше ни на что не ссылается
+	AttachmentIds []string
+}
+
+type SightingBatch struct {
+	// Sightings найденные наблюдения в порядке запроса
+	Sightings []Sighting
+	// MissingUuids идентификаторы, по которым наблюдение не найдено или удалено
+	MissingUuids []string
 }
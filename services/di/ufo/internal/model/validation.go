@@ -0,0 +1,56 @@
+package model
+
+import (
+	"strings"
+)
+
+// FieldViolation нарушение правила проверки одного поля
+type FieldViolation struct {
+	// Field путь к полю, например "description" или "[2].color"
+	Field       string
+	Description string
+	// Err ошибка конкретного правила, если вызывающему нужно его различать (например, ErrInvalidCoordinates)
+	Err error
+}
+
+// ValidationError ошибка проверки данных наблюдения со всеми нарушенными правилами сразу.
+// errors.Is находит в ней ErrInvalidSighting и ошибки отдельных нарушений.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		parts = append(parts, violation.Field+": "+violation.Description)
+	}
+
+	return ErrInvalidSighting.Error() + ": " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := []error{ErrInvalidSighting}
+	for _, violation := range e.Violations {
+		if violation.Err != nil {
+			errs = append(errs, violation.Err)
+		}
+	}
+
+	return errs
+}
+
+// Nested возвращает те же нарушения с путями внутри поля parent:
+// "location" становится "parent.location", а "[2].location" - "parent[2].location"
+func (e *ValidationError) Nested(parent string) *ValidationError {
+	violations := make([]FieldViolation, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		if strings.HasPrefix(violation.Field, "[") {
+			violation.Field = parent + violation.Field
+		} else {
+			violation.Field = parent + "." + violation.Field
+		}
+		violations = append(violations, violation)
+	}
+
+	return &ValidationError{Violations: violations}
+}
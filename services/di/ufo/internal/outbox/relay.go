@@ -0,0 +1,195 @@
+// Package outbox публикует события, которые репозиторий записывает в outbox
+// вместе с изменениями наблюдений.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/publisher"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+)
+
+type Options struct {
+	// PollInterval пауза между опросами outbox, когда новых событий нет
+	PollInterval time.Duration
+	// BatchSize сколько событий забирать за один опрос
+	BatchSize int
+	// RetryBaseDelay задержка перед первой повторной попыткой; дальше она удваивается до RetryMaxDelay
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+// Relay в фоне забирает события из outbox, публикует их и удаляет опубликованные.
+// Доставка at-least-once: событие удаляется только после успешной публикации, поэтому
+// сбой между публикацией и удалением или несколько экземпляров сервиса приводят к дубликатам.
+// Порядок публикации совпадает с порядком записи, пока публикация не откладывается
+// из-за ошибки; получатели упорядочивают события одного наблюдения по Version.
+type Relay struct {
+	repository repository.OutboxRepository
+	publisher  publisher.EventPublisher
+	options    Options
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+
+	mu sync.Mutex
+	// cancel задан, если релей запускался
+	cancel  context.CancelFunc
+	running bool
+	lastErr error
+}
+
+func NewRelay(repository repository.OutboxRepository, publisher publisher.EventPublisher, options Options) *Relay {
+	return &Relay{
+		repository: repository,
+		publisher:  publisher,
+		options:    options,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start запускает релей в отдельной горутине; повторный вызов ничего не делает
+func (r *Relay) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.running = true
+
+	go r.run(ctx)
+}
+
+// Check проба для health: релей запущен и последний опрос outbox прошел без ошибок.
+// Ошибки публикации отдельных событий не учитываются - такие события откладываются и повторяются.
+func (r *Relay) Check(_ context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.running {
+		return errors.New("outbox relay is not running")
+	}
+	if r.lastErr != nil {
+		return fmt.Errorf("outbox relay: %w", r.lastErr)
+	}
+
+	return nil
+}
+
+// Stop дожидается окончания публикации текущей пачки. Если ctx истекает раньше,
+// публикация прерывается; неудаленные события будут опубликованы после перезапуска.
+// Stop незапущенного релея и повторный Stop сразу возвращают nil.
+func (r *Relay) Stop(ctx context.Context) error {
+	r.stopOnce.Do(func() { close(r.stop) })
+
+	r.mu.Lock()
+	cancel := r.cancel
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		<-r.done
+		return ctx.Err()
+	}
+}
+
+func (r *Relay) run(ctx context.Context) {
+	defer close(r.done)
+	defer r.cancel()
+	defer func() {
+		r.mu.Lock()
+		r.running = false
+		r.mu.Unlock()
+	}()
+
+	for {
+		fetched, err := r.PublishPending(ctx)
+		if err != nil {
+			logger.Error(ctx, "failed to publish outbox events", zap.Error(err))
+		}
+
+		r.mu.Lock()
+		r.lastErr = err
+		r.mu.Unlock()
+
+		// Полная пачка - в outbox, скорее всего, есть еще события, забираем их без паузы
+		wait := r.options.PollInterval
+		if err == nil && fetched == r.options.BatchSize {
+			wait = 0
+		}
+
+		select {
+		case <-r.stop:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// PublishPending публикует одну пачку готовых событий и возвращает, сколько событий забрано.
+// Событие, которое не удалось опубликовать, откладывается с экспоненциальной задержкой.
+func (r *Relay) PublishPending(ctx context.Context) (int, error) {
+	events, err := r.repository.PendingEvents(ctx, time.Now(), r.options.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		perr := r.publisher.Publish(ctx, event)
+		if perr != nil {
+			delay := r.retryDelay(event.Attempts)
+			logger.Warn(ctx, "failed to publish outbox event, will retry",
+				zap.String("event_id", event.Id),
+				zap.Int("attempt", event.Attempts+1),
+				zap.Duration("retry_in", delay),
+				zap.Error(perr),
+			)
+
+			err = r.repository.RetryEvent(ctx, event.Id, time.Now().Add(delay), perr.Error())
+			if err != nil {
+				return len(events), err
+			}
+			continue
+		}
+
+		err = r.repository.AckEvent(ctx, event.Id)
+		if err != nil {
+			return len(events), err
+		}
+	}
+
+	return len(events), nil
+}
+
+// retryDelay задержка после attempts предыдущих неудач: RetryBaseDelay * 2^attempts, не больше RetryMaxDelay
+func (r *Relay) retryDelay(attempts int) time.Duration {
+	delay := r.options.RetryBaseDelay
+	for range attempts {
+		if delay >= r.options.RetryMaxDelay/2 {
+			return r.options.RetryMaxDelay
+		}
+		delay *= 2
+	}
+
+	return min(delay, r.options.RetryMaxDelay)
+}
@@ -0,0 +1,152 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryPublisher "github.com/baizhigit/go-ms-examples/di/ufo/internal/publisher/memory"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+var testOptions = Options{
+	PollInterval:   10 * time.Millisecond,
+	BatchSize:      2,
+	RetryBaseDelay: time.Hour,
+	RetryMaxDelay:  4 * time.Hour,
+}
+
+// flakyPublisher отказывает в публикации, пока fail не сброшен
+type flakyPublisher struct {
+	mu        sync.Mutex
+	fail      bool
+	published []model.OutboxEvent
+}
+
+func (p *flakyPublisher) Publish(_ context.Context, event model.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.fail {
+		return errors.New("broker unavailable")
+	}
+	p.published = append(p.published, event)
+
+	return nil
+}
+
+func createSightings(t *testing.T, repo repository.UFORepository, n int) []string {
+	t.Helper()
+
+	uuids := make([]string, 0, n)
+	for range n {
+		id, err := repo.Create(context.Background(), model.SightingInfo{Location: "Алматы"})
+		require.NoError(t, err)
+		uuids = append(uuids, id)
+	}
+
+	return uuids
+}
+
+func TestPublishPendingPublishesInOrderAndAcks(t *testing.T) {
+	logger.SetNopLogger()
+	ctx := context.Background()
+	repo := memoryRepository.NewRepository()
+	pub := memoryPublisher.NewPublisher(10)
+	relay := NewRelay(repo, pub, testOptions)
+
+	uuids := createSightings(t, repo, 3)
+
+	fetched, err := relay.PublishPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, fetched)
+
+	fetched, err = relay.PublishPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, fetched)
+
+	events := pub.Events()
+	require.Len(t, events, 3)
+	for i, event := range events {
+		require.Equal(t, uuids[i], event.SightingUuid)
+		require.Equal(t, model.SightingEventTypeCreated, event.Type)
+	}
+
+	pending, err := repo.PendingEvents(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestPublishPendingRetriesWithBackoff(t *testing.T) {
+	logger.SetNopLogger()
+	ctx := context.Background()
+	repo := memoryRepository.NewRepository()
+	pub := &flakyPublisher{fail: true}
+	relay := NewRelay(repo, pub, testOptions)
+
+	createSightings(t, repo, 1)
+
+	_, err := relay.PublishPending(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pub.published)
+
+	// Событие отложено на RetryBaseDelay и сейчас не забирается
+	fetched, err := relay.PublishPending(ctx)
+	require.NoError(t, err)
+	require.Zero(t, fetched)
+
+	pending, err := repo.PendingEvents(ctx, time.Now().Add(testOptions.RetryBaseDelay+time.Second), 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, 1, pending[0].Attempts)
+}
+
+func TestRetryDelay(t *testing.T) {
+	relay := NewRelay(nil, nil, testOptions)
+
+	require.Equal(t, time.Hour, relay.retryDelay(0))
+	require.Equal(t, 2*time.Hour, relay.retryDelay(1))
+	require.Equal(t, 4*time.Hour, relay.retryDelay(2))
+	require.Equal(t, 4*time.Hour, relay.retryDelay(3))
+	require.Equal(t, 4*time.Hour, relay.retryDelay(100))
+}
+
+func TestRelayPublishesInBackground(t *testing.T) {
+	logger.SetNopLogger()
+	repo := memoryRepository.NewRepository()
+	pub := memoryPublisher.NewPublisher(10)
+	relay := NewRelay(repo, pub, testOptions)
+
+	relay.Start()
+	createSightings(t, repo, 5)
+
+	require.Eventually(t, func() bool {
+		return len(pub.Events()) == 5
+	}, time.Second, testOptions.PollInterval)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, relay.Stop(ctx))
+}
+
+func TestRelayStopIsSafe(t *testing.T) {
+	logger.SetNopLogger()
+	repo := memoryRepository.NewRepository()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// Релей, который не запускали, например после ошибки инициализации
+	require.NoError(t, NewRelay(repo, memoryPublisher.NewPublisher(10), testOptions).Stop(ctx))
+
+	relay := NewRelay(repo, memoryPublisher.NewPublisher(10), testOptions)
+	relay.Start()
+	require.NoError(t, relay.Stop(ctx))
+	require.NoError(t, relay.Stop(ctx))
+}
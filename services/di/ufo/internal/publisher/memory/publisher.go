@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/publisher"
+)
+
+var _ def.EventPublisher = (*publisher)(nil)
+
+// publisher хранит последние опубликованные события в памяти процесса:
+// для тестов и локального запуска без внешних получателей
+type publisher struct {
+	mu       sync.Mutex
+	events   []model.OutboxEvent
+	capacity int
+}
+
+func NewPublisher(capacity int) *publisher {
+	return &publisher{
+		events:   make([]model.OutboxEvent, 0, capacity),
+		capacity: capacity,
+	}
+}
+
+func (p *publisher) Publish(_ context.Context, event model.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.events) == p.capacity {
+		p.events = slices.Delete(p.events, 0, 1)
+	}
+	p.events = append(p.events, event)
+
+	return nil
+}
+
+// Events возвращает опубликованные события в порядке публикации
+func (p *publisher) Events() []model.OutboxEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.events)
+}
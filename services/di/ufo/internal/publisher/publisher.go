@@ -0,0 +1,14 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// EventPublisher доставляет события outbox получателям. Ошибка означает, что событие
+// будет отправлено повторно; доставка at-least-once, поэтому получатели отбрасывают
+// дубликаты по Id события.
+type EventPublisher interface {
+	Publish(ctx context.Context, event model.OutboxEvent) error
+}
@@ -0,0 +1,70 @@
+package writer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/publisher"
+)
+
+var _ def.EventPublisher = (*publisher)(nil)
+
+// message одна строка NDJSON на событие
+type message struct {
+	Id           string          `json:"id"`
+	Type         string          `json:"type"`
+	SightingUuid string          `json:"sighting_uuid"`
+	Version      int64           `json:"version"`
+	OccurredAt   time.Time       `json:"occurred_at"`
+	Payload      json.RawMessage `json:"payload"`
+}
+
+// publisher пишет события строками NDJSON в stdout или файл
+type publisher struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewPublisher(w io.Writer) *publisher {
+	return &publisher{w: w}
+}
+
+func (p *publisher) Publish(_ context.Context, event model.OutboxEvent) error {
+	line, err := json.Marshal(message{
+		Id:           event.Id,
+		Type:         eventTypeName(event.Type),
+		SightingUuid: event.SightingUuid,
+		Version:      event.Version,
+		OccurredAt:   event.OccurredAt.UTC(),
+		Payload:      event.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Строка пишется одним вызовом, чтобы параллельные записи не перемешивались
+	_, err = p.w.Write(append(line, '\n'))
+	return err
+}
+
+func eventTypeName(eventType model.SightingEventType) string {
+	switch eventType {
+	case model.SightingEventTypeCreated:
+		return "SightingCreated"
+	case model.SightingEventTypeUpdated:
+		return "SightingUpdated"
+	case model.SightingEventTypeDeleted:
+		return "SightingDeleted"
+	case model.SightingEventTypeRestored:
+		return "SightingRestored"
+	default:
+		return "SightingUnspecified"
+	}
+}
@@ -0,0 +1,35 @@
+package writer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func TestPublishWritesNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPublisher(&buf)
+
+	occurredAt := time.Date(2024, 6, 15, 10, 21, 7, 0, time.UTC)
+	for _, eventType := range []model.SightingEventType{model.SightingEventTypeCreated, model.SightingEventTypeDeleted} {
+		err := p.Publish(context.Background(), model.OutboxEvent{
+			Id:           "0190f1d2-0000-7000-8000-000000000001",
+			Type:         eventType,
+			SightingUuid: "550e8400-e29b-41d4-a716-446655440000",
+			Version:      1,
+			Payload:      []byte(`{"uuid":"550e8400-e29b-41d4-a716-446655440000"}`),
+			OccurredAt:   occurredAt,
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t,
+		`{"id":"0190f1d2-0000-7000-8000-000000000001","type":"SightingCreated","sighting_uuid":"550e8400-e29b-41d4-a716-446655440000","version":1,"occurred_at":"2024-06-15T10:21:07Z","payload":{"uuid":"550e8400-e29b-41d4-a716-446655440000"}}`+"\n"+
+			`{"id":"0190f1d2-0000-7000-8000-000000000001","type":"SightingDeleted","sighting_uuid":"550e8400-e29b-41d4-a716-446655440000","version":1,"occurred_at":"2024-06-15T10:21:07Z","payload":{"uuid":"550e8400-e29b-41d4-a716-446655440000"}}`+"\n",
+		buf.String())
+}
@@ -0,0 +1,96 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+)
+
+func attachment(fileName string) model.Attachment {
+	return model.Attachment{
+		Id:          uuid.NewString(),
+		FileName:    fileName,
+		ContentType: "image/jpeg",
+		SizeBytes:   1024,
+		Sha256:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		CreatedAt:   timestamp(time.Now()),
+	}
+}
+
+func (s *UFORepositorySuite) TestAddAttachment() {
+	id := s.create(sightingInfo(time.Now()))
+	first := attachment("first.jpg")
+	second := attachment("second.jpg")
+
+	_, err := s.repo.AddAttachment(s.ctx, id, first)
+	s.Require().NoError(err)
+	updated, err := s.repo.AddAttachment(s.ctx, id, second)
+	s.Require().NoError(err)
+	s.Equal(int64(3), updated.Version)
+	s.NotNil(updated.UpdatedAt)
+
+	// Вложения возвращаются в порядке загрузки
+	stored, err := s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Len(stored.Attachments, 2)
+	for i, expected := range []model.Attachment{first, second} {
+		actual := stored.Attachments[i]
+		s.equalTime(&expected.CreatedAt, &actual.CreatedAt)
+		expected.CreatedAt, actual.CreatedAt = time.Time{}, time.Time{}
+		s.Equal(expected, actual)
+	}
+}
+
+func (s *UFORepositorySuite) TestNoAttachments() {
+	id := s.create(sightingInfo(time.Now()))
+
+	stored, err := s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.Empty(stored.Attachments)
+}
+
+func (s *UFORepositorySuite) TestAddAttachmentNotFound() {
+	_, err := s.repo.AddAttachment(s.ctx, uuid.NewString(), attachment("photo.jpg"))
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
+
+func (s *UFORepositorySuite) TestAddAttachmentDeleted() {
+	id := s.create(sightingInfo(time.Now()))
+	err := s.repo.Delete(s.ctx, id, nil)
+	s.Require().NoError(err)
+
+	_, err = s.repo.AddAttachment(s.ctx, id, attachment("photo.jpg"))
+	s.ErrorIs(err, model.ErrSightingDeleted)
+}
+
+func (s *UFORepositorySuite) TestAddAttachmentRecordsRevision() {
+	id := s.create(sightingInfo(time.Now()))
+	photo := attachment("photo.jpg")
+
+	updated, err := s.repo.AddAttachment(s.ctx, id, photo)
+	s.Require().NoError(err)
+
+	// Версия вложения есть в истории без пропусков, и ее снимок содержит вложение
+	revisions, err := s.repo.History(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Len(revisions, 2)
+
+	last := revisions[1]
+	s.Equal(updated.Version, last.Version)
+	s.Equal(model.RevisionActionUpdated, last.Action)
+	s.Empty(last.Changes)
+	s.Require().Len(last.Sighting.Attachments, 1)
+	s.Equal(photo.Id, last.Sighting.Attachments[0].Id)
+
+	if _, ok := s.repo.(repository.OutboxRepository); !ok {
+		return
+	}
+
+	events := s.pending()
+	s.Require().Len(events, 2)
+	s.Equal(model.SightingEventTypeUpdated, events[1].Type)
+	s.Equal(updated.Version, events[1].Version)
+}
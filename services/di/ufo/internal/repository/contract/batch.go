@@ -0,0 +1,49 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestBatchCreate() {
+	infos := []model.SightingInfo{
+		sightingInfo(time.Now().Add(-time.Hour)),
+		sightingInfo(time.Now().Add(-2 * time.Hour)),
+		{Location: "Шымкент", Description: "Без подробностей"},
+	}
+
+	results, err := s.repo.BatchCreate(s.ctx, infos)
+	s.Require().NoError(err)
+	s.Require().Len(results, len(infos))
+
+	for i, result := range results {
+		s.Require().NoError(result.Err)
+
+		sighting, err := s.repo.Get(s.ctx, result.Uuid)
+		s.Require().NoError(err)
+		s.equalInfo(infos[i], sighting.Info)
+		s.Equal(int64(1), sighting.Version)
+	}
+}
+
+func (s *UFORepositorySuite) TestBatchGet() {
+	first := s.create(sightingInfo(time.Now()))
+	second := s.create(sightingInfo(time.Now()))
+	deleted := s.create(sightingInfo(time.Now()))
+
+	err := s.repo.Delete(s.ctx, deleted, nil)
+	s.Require().NoError(err)
+
+	sightings, err := s.repo.BatchGet(s.ctx, []string{first, second, deleted, uuid.NewString(), "not-a-uuid"})
+	s.Require().NoError(err)
+
+	// Порядок результата не гарантируется
+	found := make([]string, 0, len(sightings))
+	for _, sighting := range sightings {
+		found = append(found, sighting.Uuid)
+	}
+	s.ElementsMatch([]string{first, second}, found)
+}
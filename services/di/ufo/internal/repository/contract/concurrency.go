@@ -0,0 +1,102 @@
+package contract
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+const concurrentWriters = 10
+
+func (s *UFORepositorySuite) TestConcurrentUpdatesAreNotLost() {
+	id := s.create(sightingInfo(time.Now()))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrentWriters)
+	for range concurrentWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+				Description: ptr("Параллельная правка"),
+			}, nil)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		s.NoError(err)
+	}
+
+	stored, err := s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.Equal(int64(1+concurrentWriters), stored.Version)
+}
+
+func (s *UFORepositorySuite) TestConcurrentUpdatesSameVersionOnlyOneWins() {
+	id := s.create(sightingInfo(time.Now()))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrentWriters)
+	for range concurrentWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+				Description: ptr("Правка по версии 1"),
+			}, ptr(int64(1)))
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var succeeded int
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, model.ErrVersionConflict):
+			s.Failf("unexpected error", "%v", err)
+		}
+	}
+	s.Equal(1, succeeded)
+
+	stored, err := s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.Equal(int64(2), stored.Version)
+}
+
+func (s *UFORepositorySuite) TestConcurrentDeleteOnlyOneWins() {
+	id := s.create(sightingInfo(time.Now()))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrentWriters)
+	for range concurrentWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			errs <- s.repo.Delete(s.ctx, id, nil)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var succeeded int
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, model.ErrSightingDeleted):
+			s.Failf("unexpected error", "%v", err)
+		}
+	}
+	s.Equal(1, succeeded)
+}
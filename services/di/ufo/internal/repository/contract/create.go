@@ -0,0 +1,51 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestCreateAndGet() {
+	info := sightingInfo(time.Now().Add(-time.Hour))
+	before := time.Now().Add(-time.Second)
+
+	uuid := s.create(info)
+
+	sighting, err := s.repo.Get(s.ctx, uuid)
+	s.Require().NoError(err)
+
+	s.Equal(uuid, sighting.Uuid)
+	s.equalInfo(info, sighting.Info)
+	s.WithinRange(sighting.CreatedAt, before, time.Now().Add(time.Second))
+	s.Nil(sighting.UpdatedAt)
+	s.Nil(sighting.DeletedAt)
+	s.Equal(int64(1), sighting.Version)
+}
+
+func (s *UFORepositorySuite) TestCreateOptionalFieldsEmpty() {
+	info := model.SightingInfo{
+		Location:    "Где-то в степи",
+		Description: "Без подробностей",
+	}
+
+	uuid := s.create(info)
+
+	sighting, err := s.repo.Get(s.ctx, uuid)
+	s.Require().NoError(err)
+
+	s.Nil(sighting.Info.ObservedAt)
+	s.Nil(sighting.Info.Color)
+	s.Nil(sighting.Info.Sound)
+	s.Nil(sighting.Info.DurationSeconds)
+	s.equalInfo(info, sighting.Info)
+}
+
+func (s *UFORepositorySuite) TestCreateGeneratesUniqueUUIDs() {
+	info := sightingInfo(time.Now())
+
+	first := s.create(info)
+	second := s.create(info)
+
+	s.NotEqual(first, second)
+}
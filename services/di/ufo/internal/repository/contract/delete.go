@@ -0,0 +1,45 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestDeleteHidesSighting() {
+	id := s.create(sightingInfo(time.Now()))
+
+	err := s.repo.Delete(s.ctx, id, nil)
+	s.Require().NoError(err)
+
+	_, err = s.repo.Get(s.ctx, id)
+	s.ErrorIs(err, model.ErrSightingDeleted)
+
+	_, err = s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+		Description: ptr("Правка удаленного"),
+	}, nil)
+	s.ErrorIs(err, model.ErrSightingDeleted)
+
+	err = s.repo.Delete(s.ctx, id, nil)
+	s.ErrorIs(err, model.ErrSightingDeleted)
+}
+
+func (s *UFORepositorySuite) TestDeleteNotFound() {
+	err := s.repo.Delete(s.ctx, uuid.NewString(), nil)
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
+
+func (s *UFORepositorySuite) TestDeleteExpectedVersion() {
+	id := s.create(sightingInfo(time.Now()))
+
+	err := s.repo.Delete(s.ctx, id, ptr(int64(2)))
+	s.ErrorIs(err, model.ErrVersionConflict)
+
+	_, err = s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+
+	err = s.repo.Delete(s.ctx, id, ptr(int64(1)))
+	s.NoError(err)
+}
@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestGetNotFound() {
+	_, err := s.repo.Get(s.ctx, uuid.NewString())
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
+
+func (s *UFORepositorySuite) TestGetMalformedUUIDNotFound() {
+	_, err := s.repo.Get(s.ctx, "not-a-uuid")
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
@@ -0,0 +1,123 @@
+package contract
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/history"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestHistoryRecordsEveryChange() {
+	ctx := history.WithActor(s.ctx, "witness-42")
+
+	info := sightingInfo(time.Now().Add(-time.Hour))
+	id, err := s.repo.Create(ctx, info)
+	s.Require().NoError(err)
+
+	_, err = s.repo.Update(ctx, id, model.SightingUpdateInfo{
+		Description: ptr("Уточненное описание"),
+		Color:       ptr("зеленый"),
+	}, nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.Delete(ctx, id, nil))
+	s.Require().NoError(s.repo.Restore(s.ctx, id))
+
+	revisions, err := s.repo.History(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Len(revisions, 4)
+
+	actions := []model.RevisionAction{
+		model.RevisionActionCreated,
+		model.RevisionActionUpdated,
+		model.RevisionActionDeleted,
+		model.RevisionActionRestored,
+	}
+	for i, revision := range revisions {
+		s.Equal(id, revision.SightingUuid)
+		s.Equal(int64(i+1), revision.Version)
+		s.Equal(actions[i], revision.Action)
+		s.Equal(revision.Version, revision.Sighting.Version)
+	}
+
+	// Создание перечисляет все заполненные поля
+	s.Len(revisions[0].Changes, 6)
+	s.Equal("witness-42", revisions[0].Actor)
+
+	// Цвет не изменился, в ревизию попадает только описание
+	s.Equal([]model.FieldChange{{
+		Field:    "description",
+		OldValue: ptr(info.Description),
+		NewValue: ptr("Уточненное описание"),
+	}}, revisions[1].Changes)
+	s.Equal(info.Description, revisions[0].Sighting.Info.Description)
+	s.Equal("Уточненное описание", revisions[1].Sighting.Info.Description)
+
+	s.Empty(revisions[2].Changes)
+	s.NotNil(revisions[2].Sighting.DeletedAt)
+	s.Nil(revisions[3].Sighting.DeletedAt)
+	s.Empty(revisions[3].Actor)
+}
+
+func (s *UFORepositorySuite) TestHistoryBatchCreate() {
+	results, err := s.repo.BatchCreate(s.ctx, []model.SightingInfo{
+		sightingInfo(time.Now()),
+		sightingInfo(time.Now()),
+	})
+	s.Require().NoError(err)
+
+	for _, result := range results {
+		s.Require().NoError(result.Err)
+
+		revisions, herr := s.repo.History(s.ctx, result.Uuid)
+		s.Require().NoError(herr)
+		s.Require().Len(revisions, 1)
+		s.Equal(model.RevisionActionCreated, revisions[0].Action)
+	}
+}
+
+func (s *UFORepositorySuite) TestHistoryConcurrentUpdatesAreContiguous() {
+	id := s.create(sightingInfo(time.Now()))
+
+	var wg sync.WaitGroup
+	for range concurrentWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+				Description: ptr("Параллельная правка"),
+			}, nil)
+			s.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	// У каждой версии ровно одна ревизия
+	revisions, err := s.repo.History(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Len(revisions, 1+concurrentWriters)
+	for i, revision := range revisions {
+		s.Equal(int64(i+1), revision.Version)
+	}
+}
+
+func (s *UFORepositorySuite) TestHistorySurvivesPurge() {
+	id := s.create(sightingInfo(time.Now()))
+	s.Require().NoError(s.repo.Delete(s.ctx, id, nil))
+
+	_, err := s.repo.Purge(s.ctx, time.Now().Add(time.Second))
+	s.Require().NoError(err)
+
+	revisions, err := s.repo.History(s.ctx, id)
+	s.Require().NoError(err)
+	s.Len(revisions, 2)
+}
+
+func (s *UFORepositorySuite) TestHistoryNotFound() {
+	_, err := s.repo.History(s.ctx, uuid.NewString())
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
@@ -0,0 +1,122 @@
+package contract
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// idempotencyKey возвращает новый ключ, действующий час
+func idempotencyKey(requestHash string) model.IdempotencyKey {
+	return model.IdempotencyKey{
+		Key:         uuid.NewString(),
+		RequestHash: requestHash,
+		ExpiresAt:   timestamp(time.Now().Add(time.Hour)),
+	}
+}
+
+func (s *UFORepositorySuite) TestCreateIdempotentRepeatReturnsFirstResult() {
+	info := sightingInfo(time.Now())
+	key := idempotencyKey("hash-1")
+
+	first, err := s.repo.CreateIdempotent(s.ctx, info, key)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(first.Uuid)
+	s.Equal(key.Key, first.Key)
+	s.Equal("hash-1", first.RequestHash)
+
+	sighting, err := s.repo.Get(s.ctx, first.Uuid)
+	s.Require().NoError(err)
+	s.equalInfo(info, sighting.Info)
+
+	// Повтор с другим отпечатком не создает наблюдение, а возвращает запись первого запроса
+	key.RequestHash = "hash-2"
+	repeat, err := s.repo.CreateIdempotent(s.ctx, info, key)
+	s.Require().NoError(err)
+	s.Equal(first.Uuid, repeat.Uuid)
+	s.Equal("hash-1", repeat.RequestHash)
+	s.True(first.ExpiresAt.Equal(repeat.ExpiresAt))
+
+	list, err := s.repo.List(s.ctx, model.SightingListQuery{PageSize: 10})
+	s.Require().NoError(err)
+	s.Len(list.Sightings, 1)
+}
+
+func (s *UFORepositorySuite) TestCreateIdempotentExpiredKeyIsReplaced() {
+	key := idempotencyKey("hash-1")
+	key.ExpiresAt = timestamp(time.Now().Add(-time.Minute))
+
+	first, err := s.repo.CreateIdempotent(s.ctx, sightingInfo(time.Now()), key)
+	s.Require().NoError(err)
+
+	key = idempotencyKey("hash-2")
+	key.Key = first.Key
+	second, err := s.repo.CreateIdempotent(s.ctx, sightingInfo(time.Now()), key)
+	s.Require().NoError(err)
+	s.NotEqual(first.Uuid, second.Uuid)
+	s.Equal("hash-2", second.RequestHash)
+
+	repeat, err := s.repo.CreateIdempotent(s.ctx, sightingInfo(time.Now()), key)
+	s.Require().NoError(err)
+	s.Equal(second.Uuid, repeat.Uuid)
+}
+
+func (s *UFORepositorySuite) TestCreateIdempotentConcurrentCreatesOnce() {
+	key := idempotencyKey("hash-1")
+
+	var wg sync.WaitGroup
+	uuids := make(chan string, concurrentWriters)
+	for range concurrentWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result, err := s.repo.CreateIdempotent(s.ctx, sightingInfo(time.Now()), key)
+			s.NoError(err)
+			uuids <- result.Uuid
+		}()
+	}
+	wg.Wait()
+	close(uuids)
+
+	var first string
+	for id := range uuids {
+		if first == "" {
+			first = id
+		}
+		s.Equal(first, id)
+	}
+
+	list, err := s.repo.List(s.ctx, model.SightingListQuery{PageSize: 2 * concurrentWriters})
+	s.Require().NoError(err)
+	s.Len(list.Sightings, 1)
+}
+
+func (s *UFORepositorySuite) TestCreateIdempotentFailedInsertReleasesKey() {
+	if s.breakInserts == nil {
+		s.T().Skip("repository cannot simulate a failed insert")
+	}
+
+	info := sightingInfo(time.Now())
+	key := idempotencyKey("hash-1")
+
+	restore := s.breakInserts(s.T())
+	_, err := s.repo.CreateIdempotent(s.ctx, info, key)
+	restore()
+	s.Require().Error(err)
+
+	// Ключ не указывает на несохраненное наблюдение: повтор создает его заново
+	result, err := s.repo.CreateIdempotent(s.ctx, info, key)
+	s.Require().NoError(err)
+
+	sighting, err := s.repo.Get(s.ctx, result.Uuid)
+	s.Require().NoError(err)
+	s.equalInfo(info, sighting.Info)
+
+	list, err := s.repo.List(s.ctx, model.SightingListQuery{PageSize: 10})
+	s.Require().NoError(err)
+	s.Len(list.Sightings, 1)
+}
@@ -0,0 +1,125 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// listAll проходит по всем страницам и возвращает UUID в порядке выдачи
+func (s *UFORepositorySuite) listAll(filter model.SightingFilter, pageSize int32) []string {
+	var (
+		uuids     []string
+		pageToken string
+	)
+
+	for {
+		list, err := s.repo.List(s.ctx, model.SightingListQuery{
+			Filter:    filter,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(list.Sightings), int(pageSize))
+
+		for _, sighting := range list.Sightings {
+			uuids = append(uuids, sighting.Uuid)
+		}
+
+		if list.NextPageToken == "" {
+			return uuids
+		}
+		pageToken = list.NextPageToken
+	}
+}
+
+func (s *UFORepositorySuite) TestListOrderAndPagination() {
+	base := time.Now().Add(-24 * time.Hour)
+
+	oldest := s.create(sightingInfo(base))
+	newest := s.create(sightingInfo(base.Add(2 * time.Hour)))
+	middle := s.create(sightingInfo(base.Add(time.Hour)))
+
+	undated := sightingInfo(base)
+	undated.ObservedAt = nil
+	withoutDate := s.create(undated)
+
+	// Новые наблюдения первыми, наблюдения без даты - в конце
+	s.Equal([]string{newest, middle, oldest, withoutDate}, s.listAll(model.SightingFilter{}, 2))
+	s.Equal([]string{newest, middle, oldest, withoutDate}, s.listAll(model.SightingFilter{}, 3))
+	s.Equal([]string{newest, middle, oldest, withoutDate}, s.listAll(model.SightingFilter{}, 10))
+}
+
+func (s *UFORepositorySuite) TestListSameObservedAtPagination() {
+	observedAt := time.Now().Add(-time.Hour)
+
+	created := make(map[string]struct{})
+	for range 5 {
+		created[s.create(sightingInfo(observedAt))] = struct{}{}
+	}
+
+	listed := s.listAll(model.SightingFilter{}, 2)
+	s.Len(listed, len(created))
+	for _, uuid := range listed {
+		s.Contains(created, uuid)
+	}
+}
+
+func (s *UFORepositorySuite) TestListFilters() {
+	base := time.Now().Add(-24 * time.Hour)
+
+	info := sightingInfo(base)
+	matching := s.create(info)
+
+	otherColor := sightingInfo(base)
+	otherColor.Color = ptr("красный")
+	s.create(otherColor)
+
+	silent := sightingInfo(base)
+	silent.Sound = ptr(false)
+	s.create(silent)
+
+	elsewhere := sightingInfo(base)
+	elsewhere.Location = "Караганда"
+	s.create(elsewhere)
+
+	tooEarly := sightingInfo(base.Add(-48 * time.Hour))
+	s.create(tooEarly)
+
+	listed := s.listAll(model.SightingFilter{
+		ObservedFrom: ptr(base.Add(-time.Hour)),
+		ObservedTo:   ptr(base.Add(time.Hour)),
+		Location:     ptr("медеу"),
+		Color:        info.Color,
+		Sound:        ptr(true),
+	}, 10)
+
+	s.Equal([]string{matching}, listed)
+}
+
+func (s *UFORepositorySuite) TestListObservedRangeIsHalfOpen() {
+	observedAt := timestamp(time.Now().Add(-time.Hour))
+	id := s.create(sightingInfo(observedAt))
+
+	s.Equal([]string{id}, s.listAll(model.SightingFilter{ObservedFrom: &observedAt}, 10))
+	s.Empty(s.listAll(model.SightingFilter{ObservedTo: &observedAt}, 10))
+}
+
+func (s *UFORepositorySuite) TestListIncludeDeleted() {
+	alive := s.create(sightingInfo(time.Now().Add(-time.Hour)))
+	deleted := s.create(sightingInfo(time.Now().Add(-2 * time.Hour)))
+
+	err := s.repo.Delete(s.ctx, deleted, nil)
+	s.Require().NoError(err)
+
+	s.Equal([]string{alive}, s.listAll(model.SightingFilter{}, 10))
+	s.Equal([]string{alive, deleted}, s.listAll(model.SightingFilter{IncludeDeleted: true}, 10))
+}
+
+func (s *UFORepositorySuite) TestListInvalidPageToken() {
+	_, err := s.repo.List(s.ctx, model.SightingListQuery{
+		PageSize:  10,
+		PageToken: "%%%",
+	})
+	s.ErrorIs(err, model.ErrInvalidPageToken)
+}
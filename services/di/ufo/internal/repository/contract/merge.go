@@ -0,0 +1,68 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestMergeUpdatesTargetAndDeletesDuplicates() {
+	target := s.create(sightingInfo(time.Now()))
+	first := s.create(sightingInfo(time.Now()))
+	second := s.create(sightingInfo(time.Now()))
+
+	merged, err := s.repo.Merge(s.ctx, target, model.SightingUpdateInfo{
+		Color: ptr("оранжевый"),
+	}, ptr(int64(1)), []string{first, second})
+	s.Require().NoError(err)
+	s.Equal(target, merged.Uuid)
+	s.Equal(int64(2), merged.Version)
+	s.Require().NotNil(merged.Info.Color)
+	s.Equal("оранжевый", *merged.Info.Color)
+
+	for _, id := range []string{first, second} {
+		_, err = s.repo.Get(s.ctx, id)
+		s.ErrorIs(err, model.ErrSightingDeleted)
+
+		// Дубликат восстанавливается как обычное мягко удаленное наблюдение
+		history, herr := s.repo.History(s.ctx, id)
+		s.Require().NoError(herr)
+		s.Require().Len(history, 2)
+		s.Equal(model.RevisionActionDeleted, history[1].Action)
+	}
+}
+
+func (s *UFORepositorySuite) TestMergeMissingDuplicateChangesNothing() {
+	target := s.create(sightingInfo(time.Now()))
+	duplicate := s.create(sightingInfo(time.Now()))
+	deleted := s.create(sightingInfo(time.Now()))
+	s.Require().NoError(s.repo.Delete(s.ctx, deleted, nil))
+
+	missing := uuid.NewString()
+	_, err := s.repo.Merge(s.ctx, target, model.SightingUpdateInfo{}, nil, []string{duplicate, missing})
+	s.Require().ErrorIs(err, model.ErrSightingNotFound)
+	s.Contains(err.Error(), missing)
+
+	_, err = s.repo.Merge(s.ctx, target, model.SightingUpdateInfo{}, nil, []string{duplicate, deleted})
+	s.Require().ErrorIs(err, model.ErrSightingDeleted)
+	s.Contains(err.Error(), deleted)
+
+	for _, id := range []string{target, duplicate} {
+		sighting, gerr := s.repo.Get(s.ctx, id)
+		s.Require().NoError(gerr)
+		s.Equal(int64(1), sighting.Version)
+	}
+}
+
+func (s *UFORepositorySuite) TestMergeExpectedVersion() {
+	target := s.create(sightingInfo(time.Now()))
+	duplicate := s.create(sightingInfo(time.Now()))
+
+	_, err := s.repo.Merge(s.ctx, target, model.SightingUpdateInfo{}, ptr(int64(5)), []string{duplicate})
+	s.Require().ErrorIs(err, model.ErrVersionConflict)
+
+	_, err = s.repo.Get(s.ctx, duplicate)
+	s.NoError(err)
+}
@@ -0,0 +1,82 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+var (
+	almaty = model.GeoPoint{Latitude: 43.2389, Longitude: 76.8897}
+	medeu  = model.GeoPoint{Latitude: 43.1573, Longitude: 77.0589}
+	astana = model.GeoPoint{Latitude: 51.1694, Longitude: 71.4491}
+)
+
+// createAt создает наблюдение с координатами
+func (s *UFORepositorySuite) createAt(location string, point model.GeoPoint) string {
+	info := sightingInfo(time.Now())
+	info.Location = location
+	info.Coordinates = &point
+
+	return s.create(info)
+}
+
+func (s *UFORepositorySuite) TestCoordinatesRoundTrip() {
+	uuid := s.createAt("Алматы", almaty)
+
+	sighting, err := s.repo.Get(s.ctx, uuid)
+	s.Require().NoError(err)
+	s.Require().NotNil(sighting.Info.Coordinates)
+	s.InDelta(almaty.Latitude, sighting.Info.Coordinates.Latitude, 1e-9)
+	s.InDelta(almaty.Longitude, sighting.Info.Coordinates.Longitude, 1e-9)
+
+	withoutCoordinates := s.create(sightingInfo(time.Now()))
+	sighting, err = s.repo.Get(s.ctx, withoutCoordinates)
+	s.Require().NoError(err)
+	s.Nil(sighting.Info.Coordinates)
+}
+
+func (s *UFORepositorySuite) TestUpdateCoordinates() {
+	uuid := s.createAt("Алматы", almaty)
+
+	updated, err := s.repo.Update(s.ctx, uuid, model.SightingUpdateInfo{Coordinates: &medeu}, nil)
+	s.Require().NoError(err)
+	s.Require().NotNil(updated.Info.Coordinates)
+	s.InDelta(medeu.Latitude, updated.Info.Coordinates.Latitude, 1e-9)
+	s.InDelta(medeu.Longitude, updated.Info.Coordinates.Longitude, 1e-9)
+}
+
+func (s *UFORepositorySuite) TestFindNearby() {
+	inAlmaty := s.createAt("Алматы", almaty)
+	inMedeu := s.createAt("Медеу", medeu)
+	s.createAt("Астана", astana)
+	s.create(sightingInfo(time.Now()))
+
+	deleted := s.createAt("Алматы", almaty)
+	err := s.repo.Delete(s.ctx, deleted, nil)
+	s.Require().NoError(err)
+
+	found, err := s.repo.FindNearby(s.ctx, model.NearbyQuery{
+		Center:       model.GeoPoint{Latitude: 43.2400, Longitude: 76.8900},
+		RadiusMeters: 50_000,
+		Limit:        10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(found, 2)
+
+	// Ближайшие первыми, расстояние считается по сфере
+	s.Equal(inAlmaty, found[0].Sighting.Uuid)
+	s.Equal(inMedeu, found[1].Sighting.Uuid)
+	s.Less(found[0].DistanceMeters, 500.0)
+	s.InDelta(16_000, found[1].DistanceMeters, 1_000)
+}
+
+func (s *UFORepositorySuite) TestFindNearbyLimit() {
+	for range 3 {
+		s.createAt("Алматы", almaty)
+	}
+
+	found, err := s.repo.FindNearby(s.ctx, model.NearbyQuery{Center: almaty, RadiusMeters: 1_000, Limit: 2})
+	s.Require().NoError(err)
+	s.Len(found, 2)
+}
@@ -0,0 +1,174 @@
+package contract
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+)
+
+// outboxLimit больше числа событий в любом тесте
+const outboxLimit = 100
+
+// outbox возвращает репозиторий как OutboxRepository или пропускает тест
+func (s *UFORepositorySuite) outbox() repository.OutboxRepository {
+	outbox, ok := s.repo.(repository.OutboxRepository)
+	if !ok {
+		s.T().Skip("outbox is not supported by storage")
+	}
+
+	return outbox
+}
+
+// pending возвращает все события, готовые к публикации сейчас
+func (s *UFORepositorySuite) pending() []model.OutboxEvent {
+	events, err := s.outbox().PendingEvents(s.ctx, time.Now(), outboxLimit)
+	s.Require().NoError(err)
+
+	return events
+}
+
+func (s *UFORepositorySuite) TestOutboxRecordsEveryChange() {
+	s.outbox()
+
+	id := s.create(sightingInfo(time.Now()))
+
+	_, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{Description: ptr("Уточненное описание")}, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.Delete(s.ctx, id, nil))
+	s.Require().NoError(s.repo.Restore(s.ctx, id))
+
+	events := s.pending()
+	s.Require().Len(events, 4)
+
+	types := []model.SightingEventType{
+		model.SightingEventTypeCreated,
+		model.SightingEventTypeUpdated,
+		model.SightingEventTypeDeleted,
+		model.SightingEventTypeRestored,
+	}
+	for i, event := range events {
+		s.NotEmpty(event.Id)
+		s.Equal(types[i], event.Type)
+		s.Equal(id, event.SightingUuid)
+		s.Equal(int64(i+1), event.Version)
+		s.Zero(event.Attempts)
+	}
+
+	// Событие несет снимок наблюдения после изменения
+	var payload struct {
+		Uuid    string `json:"uuid"`
+		Version int64  `json:"version"`
+		Info    struct {
+			Description string `json:"description"`
+		} `json:"info"`
+	}
+	s.Require().NoError(json.Unmarshal(events[1].Payload, &payload))
+	s.Equal(id, payload.Uuid)
+	s.Equal(int64(2), payload.Version)
+	s.Equal("Уточненное описание", payload.Info.Description)
+}
+
+func (s *UFORepositorySuite) TestOutboxFailedWriteRecordsNothing() {
+	s.outbox()
+
+	id := s.create(sightingInfo(time.Now()))
+
+	_, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{Description: ptr("Правка")}, ptr(int64(5)))
+	s.Require().ErrorIs(err, model.ErrVersionConflict)
+
+	s.Len(s.pending(), 1)
+}
+
+func (s *UFORepositorySuite) TestOutboxBatchCreate() {
+	outbox := s.outbox()
+
+	results, err := s.repo.BatchCreate(s.ctx, []model.SightingInfo{
+		sightingInfo(time.Now()),
+		sightingInfo(time.Now()),
+		sightingInfo(time.Now()),
+	})
+	s.Require().NoError(err)
+
+	events := s.pending()
+	s.Require().Len(events, len(results))
+	for i, event := range events {
+		s.Equal(results[i].Uuid, event.SightingUuid)
+		s.Equal(model.SightingEventTypeCreated, event.Type)
+	}
+
+	limited, err := outbox.PendingEvents(s.ctx, time.Now(), 2)
+	s.Require().NoError(err)
+	s.Equal(events[:2], limited)
+}
+
+func (s *UFORepositorySuite) TestOutboxAckEvent() {
+	outbox := s.outbox()
+
+	first := s.create(sightingInfo(time.Now()))
+	second := s.create(sightingInfo(time.Now()))
+
+	events := s.pending()
+	s.Require().Len(events, 2)
+	s.Require().Equal(first, events[0].SightingUuid)
+
+	s.Require().NoError(outbox.AckEvent(s.ctx, events[0].Id))
+	// Повторное подтверждение, например после сбоя релея, не ошибка
+	s.Require().NoError(outbox.AckEvent(s.ctx, events[0].Id))
+
+	events = s.pending()
+	s.Require().Len(events, 1)
+	s.Equal(second, events[0].SightingUuid)
+}
+
+func (s *UFORepositorySuite) TestOutboxRetryEventPostpones() {
+	outbox := s.outbox()
+
+	s.create(sightingInfo(time.Now()))
+
+	events := s.pending()
+	s.Require().Len(events, 1)
+
+	nextAttemptAt := time.Now().Add(time.Hour)
+	s.Require().NoError(outbox.RetryEvent(s.ctx, events[0].Id, nextAttemptAt, "publisher unavailable"))
+
+	s.Empty(s.pending())
+
+	events, err := outbox.PendingEvents(s.ctx, nextAttemptAt.Add(time.Second), outboxLimit)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Equal(1, events[0].Attempts)
+}
+
+func (s *UFORepositorySuite) TestOutboxConcurrentUpdates() {
+	s.outbox()
+
+	id := s.create(sightingInfo(time.Now()))
+
+	var wg sync.WaitGroup
+	for range concurrentWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+				Description: ptr("Параллельная правка"),
+			}, nil)
+			s.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	// Без транзакций MongoDB порядок событий параллельных изменений не гарантирован,
+	// но каждая версия попадает в outbox ровно один раз
+	expected := make([]int64, 0, 1+concurrentWriters)
+	versions := make([]int64, 0, 1+concurrentWriters)
+	for i, event := range s.pending() {
+		expected = append(expected, int64(i+1))
+		versions = append(versions, event.Version)
+	}
+	s.Len(versions, 1+concurrentWriters)
+	s.ElementsMatch(expected, versions)
+}
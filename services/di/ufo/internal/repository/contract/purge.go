@@ -0,0 +1,54 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestPurge() {
+	kept := s.create(sightingInfo(time.Now()))
+	deleted := s.create(sightingInfo(time.Now()))
+
+	err := s.repo.Delete(s.ctx, deleted, nil)
+	s.Require().NoError(err)
+
+	// Удаленное позже границы не трогаем
+	purged, err := s.repo.Purge(s.ctx, time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Zero(purged.PurgedCount)
+
+	purged, err = s.repo.Purge(s.ctx, time.Now().Add(time.Second))
+	s.Require().NoError(err)
+	s.Equal(int64(1), purged.PurgedCount)
+	s.Empty(purged.AttachmentIds)
+
+	err = s.repo.Restore(s.ctx, deleted)
+	s.ErrorIs(err, model.ErrSightingNotFound)
+
+	_, err = s.repo.Get(s.ctx, kept)
+	s.NoError(err)
+}
+
+func (s *UFORepositorySuite) TestPurgeReturnsAttachments() {
+	kept := s.create(sightingInfo(time.Now()))
+	deleted := s.create(sightingInfo(time.Now()))
+
+	keptAttachment := attachment("kept.jpg")
+	_, err := s.repo.AddAttachment(s.ctx, kept, keptAttachment)
+	s.Require().NoError(err)
+
+	first, second := attachment("first.jpg"), attachment("second.jpg")
+	_, err = s.repo.AddAttachment(s.ctx, deleted, first)
+	s.Require().NoError(err)
+	_, err = s.repo.AddAttachment(s.ctx, deleted, second)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.Delete(s.ctx, deleted, nil))
+
+	// Содержимое вложений удаляет вызывающий: репозиторий сообщает, какие вложения осиротели
+	purged, err := s.repo.Purge(s.ctx, time.Now().Add(time.Second))
+	s.Require().NoError(err)
+	s.Equal(int64(1), purged.PurgedCount)
+	s.ElementsMatch([]string{first.Id, second.Id}, purged.AttachmentIds)
+}
@@ -0,0 +1,40 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestRestore() {
+	info := sightingInfo(time.Now())
+	id := s.create(info)
+
+	err := s.repo.Delete(s.ctx, id, nil)
+	s.Require().NoError(err)
+
+	err = s.repo.Restore(s.ctx, id)
+	s.Require().NoError(err)
+
+	restored, err := s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.equalInfo(info, restored.Info)
+	s.Nil(restored.DeletedAt)
+	s.NotNil(restored.UpdatedAt)
+	// Удаление и восстановление - две отдельные правки
+	s.Equal(int64(3), restored.Version)
+}
+
+func (s *UFORepositorySuite) TestRestoreNotDeleted() {
+	id := s.create(sightingInfo(time.Now()))
+
+	err := s.repo.Restore(s.ctx, id)
+	s.ErrorIs(err, model.ErrSightingNotDeleted)
+}
+
+func (s *UFORepositorySuite) TestRestoreNotFound() {
+	err := s.repo.Restore(s.ctx, uuid.NewString())
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
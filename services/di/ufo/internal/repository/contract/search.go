@@ -0,0 +1,62 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// searchUUIDs ищет наблюдения и возвращает их идентификаторы в порядке выдачи
+func (s *UFORepositorySuite) searchUUIDs(terms []string, limit int32) []string {
+	hits, err := s.repo.Search(s.ctx, model.SightingSearchQuery{Terms: terms, Limit: limit})
+	s.Require().NoError(err)
+
+	uuids := make([]string, 0, len(hits))
+	for i, hit := range hits {
+		s.Positive(hit.Score)
+		if i > 0 {
+			s.LessOrEqual(hit.Score, hits[i-1].Score, "hits must be ordered by score")
+		}
+		uuids = append(uuids, hit.Sighting.Uuid)
+	}
+
+	return uuids
+}
+
+func (s *UFORepositorySuite) TestSearch() {
+	both := s.create(model.SightingInfo{Location: "Алматы, Медеу", Description: "Светящийся треугольник над горами"})
+	byDescription := s.create(model.SightingInfo{Location: "Астана", Description: "Треугольник над рекой"})
+	byLocation := s.create(model.SightingInfo{Location: "Алматы", Description: "Оранжевый шар"})
+	s.create(model.SightingInfo{Location: "Шымкент", Description: "Оранжевый шар"})
+
+	deleted := s.create(model.SightingInfo{Location: "Алматы", Description: "Треугольник"})
+	err := s.repo.Delete(s.ctx, deleted, nil)
+	s.Require().NoError(err)
+
+	uuids := s.searchUUIDs([]string{"треугольник", "алматы"}, 10)
+
+	s.Require().Len(uuids, 3)
+	// Совпадение по обоим словам релевантнее совпадения по одному
+	s.Equal(both, uuids[0])
+	s.ElementsMatch([]string{byDescription, byLocation}, uuids[1:])
+}
+
+func (s *UFORepositorySuite) TestSearchIgnoresCase() {
+	uuid := s.create(model.SightingInfo{Location: "АЛМАТЫ", Description: "ТРЕУГОЛЬНИК"})
+
+	s.Equal([]string{uuid}, s.searchUUIDs([]string{"треугольник"}, 10))
+}
+
+func (s *UFORepositorySuite) TestSearchLimit() {
+	for range 3 {
+		s.create(model.SightingInfo{Location: "Алматы", Description: "Треугольник"})
+	}
+
+	s.Len(s.searchUUIDs([]string{"треугольник"}, 2), 2)
+}
+
+func (s *UFORepositorySuite) TestSearchNoMatches() {
+	s.create(sightingInfo(time.Now()))
+
+	s.Empty(s.searchUUIDs([]string{"дирижабль"}, 10))
+}
@@ -0,0 +1,108 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/stats"
+)
+
+// statsCounts запрашивает статистику и возвращает количество по подписям групп;
+// для группировки по времени подпись строится по началу интервала
+func (s *UFORepositorySuite) statsCounts(query model.StatsQuery) map[string]int64 {
+	buckets, err := s.repo.Stats(s.ctx, query)
+	s.Require().NoError(err)
+
+	counts := make(map[string]int64, len(buckets))
+	for _, bucket := range buckets {
+		key := bucket.Key
+		if query.GroupBy.IsTime() {
+			s.Require().NotNil(bucket.Start)
+			s.Equal(time.UTC, bucket.Start.Location())
+			key = stats.Key(*bucket.Start, query.GroupBy)
+		}
+		s.NotContains(counts, key, "duplicate bucket %q", key)
+		counts[key] = bucket.Count
+	}
+
+	return counts
+}
+
+// createObserved создает наблюдение с заданным временем, цветом и признаком звука
+func (s *UFORepositorySuite) createObserved(observedAt *time.Time, color *string, sound *bool) string {
+	info := sightingInfo(time.Now())
+	info.ObservedAt = observedAt
+	info.Color = color
+	info.Sound = sound
+
+	return s.create(info)
+}
+
+func (s *UFORepositorySuite) TestStatsByDay() {
+	s.createObserved(ptr(time.Date(2024, 6, 10, 10, 0, 0, 0, time.UTC)), nil, nil)
+	s.createObserved(ptr(time.Date(2024, 6, 10, 23, 59, 0, 0, time.UTC)), nil, nil)
+	// 01:00 по UTC+5 - это еще 11 июня по UTC
+	s.createObserved(ptr(time.Date(2024, 6, 12, 1, 0, 0, 0, time.FixedZone("UTC+5", 5*60*60))), nil, nil)
+	s.createObserved(nil, nil, nil)
+
+	deleted := s.createObserved(ptr(time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)), nil, nil)
+	err := s.repo.Delete(s.ctx, deleted, nil)
+	s.Require().NoError(err)
+
+	s.Equal(map[string]int64{
+		"2024-06-10": 2,
+		"2024-06-11": 1,
+	}, s.statsCounts(model.StatsQuery{GroupBy: model.StatsGroupByDay}))
+}
+
+func (s *UFORepositorySuite) TestStatsByWeekAndMonth() {
+	// 16 июня 2024 - воскресенье, 17 июня - понедельник следующей недели
+	s.createObserved(ptr(time.Date(2024, 6, 16, 12, 0, 0, 0, time.UTC)), nil, nil)
+	s.createObserved(ptr(time.Date(2024, 6, 17, 12, 0, 0, 0, time.UTC)), nil, nil)
+	s.createObserved(ptr(time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)), nil, nil)
+
+	s.Equal(map[string]int64{
+		"2024-W24": 1,
+		"2024-W25": 1,
+		"2024-W27": 1,
+	}, s.statsCounts(model.StatsQuery{GroupBy: model.StatsGroupByWeek}))
+
+	s.Equal(map[string]int64{
+		"2024-06": 2,
+		"2024-07": 1,
+	}, s.statsCounts(model.StatsQuery{GroupBy: model.StatsGroupByMonth}))
+}
+
+func (s *UFORepositorySuite) TestStatsByColor() {
+	observedAt := ptr(time.Date(2024, 6, 10, 10, 0, 0, 0, time.UTC))
+	s.createObserved(observedAt, ptr("зеленый"), nil)
+	s.createObserved(observedAt, ptr("зеленый"), nil)
+	s.createObserved(observedAt, ptr("красный"), nil)
+	s.createObserved(observedAt, nil, nil)
+	// Без времени наблюдения учитывается, если период не задан
+	s.createObserved(nil, ptr("красный"), nil)
+
+	s.Equal(map[string]int64{
+		"зеленый": 2,
+		"красный": 2,
+		"":        1,
+	}, s.statsCounts(model.StatsQuery{GroupBy: model.StatsGroupByColor}))
+}
+
+func (s *UFORepositorySuite) TestStatsBySoundInRange() {
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	s.createObserved(ptr(from), nil, ptr(true))
+	s.createObserved(ptr(time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)), nil, ptr(false))
+	s.createObserved(ptr(time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)), nil, nil)
+	// Граница to не включается, наблюдения без времени вне любого периода
+	s.createObserved(ptr(to), nil, ptr(true))
+	s.createObserved(nil, nil, ptr(true))
+
+	s.Equal(map[string]int64{
+		"true":  1,
+		"false": 1,
+		"":      1,
+	}, s.statsCounts(model.StatsQuery{ObservedFrom: &from, ObservedTo: &to, GroupBy: model.StatsGroupBySound}))
+}
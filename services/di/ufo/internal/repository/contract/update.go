@@ -0,0 +1,126 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *UFORepositorySuite) TestUpdateAllFields() {
+	id := s.create(sightingInfo(time.Now().Add(-time.Hour)))
+
+	newObservedAt := timestamp(time.Now().Add(-30 * time.Minute))
+	updateInfo := model.SightingUpdateInfo{
+		ObservedAt:      &newObservedAt,
+		Location:        ptr("Астана, Байтерек"),
+		Description:     ptr("Шар, зависший над башней"),
+		Color:           ptr("красный"),
+		Sound:           ptr(false),
+		DurationSeconds: ptr(int32(42)),
+	}
+
+	updated, err := s.repo.Update(s.ctx, id, updateInfo, nil)
+	s.Require().NoError(err)
+
+	expected := model.SightingInfo{
+		ObservedAt:      &newObservedAt,
+		Location:        *updateInfo.Location,
+		Description:     *updateInfo.Description,
+		Color:           updateInfo.Color,
+		Sound:           updateInfo.Sound,
+		DurationSeconds: updateInfo.DurationSeconds,
+	}
+	s.equalInfo(expected, updated.Info)
+	s.Equal(int64(2), updated.Version)
+	s.NotNil(updated.UpdatedAt)
+
+	// Возвращенное значение совпадает с сохраненным
+	stored, err := s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.equalInfo(updated.Info, stored.Info)
+	s.Equal(updated.Version, stored.Version)
+	s.equalTime(updated.UpdatedAt, stored.UpdatedAt)
+}
+
+func (s *UFORepositorySuite) TestUpdatePartialKeepsOtherFields() {
+	info := sightingInfo(time.Now().Add(-time.Hour))
+	id := s.create(info)
+
+	updated, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+		Description: ptr("Уточненное описание"),
+	}, nil)
+	s.Require().NoError(err)
+
+	expected := info
+	expected.Description = "Уточненное описание"
+	s.equalInfo(expected, updated.Info)
+}
+
+func (s *UFORepositorySuite) TestUpdateClearsFields() {
+	info := sightingInfo(time.Now().Add(-time.Hour))
+	info.Coordinates = &model.GeoPoint{Latitude: 43.1575, Longitude: 77.0586}
+	id := s.create(info)
+
+	updated, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+		Sound: ptr(false),
+		Clear: []model.SightingField{
+			model.SightingFieldObservedAt,
+			model.SightingFieldColor,
+			model.SightingFieldDurationSeconds,
+			model.SightingFieldCoordinates,
+		},
+	}, nil)
+	s.Require().NoError(err)
+
+	expected := model.SightingInfo{
+		Location:    info.Location,
+		Description: info.Description,
+		Sound:       ptr(false),
+	}
+	s.equalInfo(expected, updated.Info)
+
+	stored, err := s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.equalInfo(expected, stored.Info)
+}
+
+func (s *UFORepositorySuite) TestUpdateEmptyBumpsVersion() {
+	info := sightingInfo(time.Now())
+	id := s.create(info)
+
+	updated, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{}, nil)
+	s.Require().NoError(err)
+
+	s.equalInfo(info, updated.Info)
+	s.Equal(int64(2), updated.Version)
+}
+
+func (s *UFORepositorySuite) TestUpdateNotFound() {
+	_, err := s.repo.Update(s.ctx, uuid.NewString(), model.SightingUpdateInfo{
+		Description: ptr("Нет такого наблюдения"),
+	}, nil)
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
+
+func (s *UFORepositorySuite) TestUpdateExpectedVersion() {
+	id := s.create(sightingInfo(time.Now()))
+
+	updated, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+		Color: ptr("синий"),
+	}, ptr(int64(1)))
+	s.Require().NoError(err)
+	s.Equal(int64(2), updated.Version)
+
+	// Повтор с устаревшей версией отклоняется и ничего не меняет
+	_, err = s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+		Color: ptr("желтый"),
+	}, ptr(int64(1)))
+	s.ErrorIs(err, model.ErrVersionConflict)
+
+	stored, err := s.repo.Get(s.ctx, id)
+	s.Require().NoError(err)
+	s.Equal("синий", *stored.Info.Color)
+	s.Equal(int64(2), stored.Version)
+}
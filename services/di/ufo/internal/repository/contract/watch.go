@@ -0,0 +1,133 @@
+package contract
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// watchTimeout сколько ждать очередного события, прежде чем считать его потерянным
+const watchTimeout = 5 * time.Second
+
+// watch открывает подписку или пропускает тест, если хранилище ее не поддерживает
+func (s *UFORepositorySuite) watch(resumeToken string) model.SightingEventStream {
+	stream, err := s.repo.Watch(s.ctx, resumeToken)
+	if errors.Is(err, model.ErrWatchNotSupported) {
+		s.T().Skip("watch is not supported by storage")
+	}
+	s.Require().NoError(err)
+
+	s.T().Cleanup(func() {
+		_ = stream.Close(context.Background())
+	})
+
+	return stream
+}
+
+func (s *UFORepositorySuite) nextEvent(stream model.SightingEventStream) model.SightingEvent {
+	ctx, cancel := context.WithTimeout(s.ctx, watchTimeout)
+	defer cancel()
+
+	event, err := stream.Next(ctx)
+	s.Require().NoError(err)
+	s.NotEmpty(event.ResumeToken)
+
+	return event
+}
+
+// expectEvent читает следующее событие и сверяет его тип, наблюдение и версию
+func (s *UFORepositorySuite) expectEvent(stream model.SightingEventStream, eventType model.SightingEventType, uuid string, version int64) model.SightingEvent {
+	event := s.nextEvent(stream)
+
+	s.Equal(eventType, event.Type)
+	s.Equal(uuid, event.Uuid)
+	s.False(event.OccurredAt.IsZero())
+
+	if eventType == model.SightingEventTypePurged {
+		s.Nil(event.Sighting)
+		return event
+	}
+
+	if s.NotNil(event.Sighting) {
+		s.Equal(uuid, event.Sighting.Uuid)
+		s.Equal(version, event.Sighting.Version)
+	}
+
+	return event
+}
+
+func (s *UFORepositorySuite) TestWatchLifecycleEvents() {
+	stream := s.watch("")
+
+	id := s.create(sightingInfo(time.Now()))
+	s.expectEvent(stream, model.SightingEventTypeCreated, id, 1)
+
+	_, err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{Color: ptr("белый")}, nil)
+	s.Require().NoError(err)
+	s.expectEvent(stream, model.SightingEventTypeUpdated, id, 2)
+
+	err = s.repo.Delete(s.ctx, id, nil)
+	s.Require().NoError(err)
+	deleted := s.expectEvent(stream, model.SightingEventTypeDeleted, id, 3)
+	if s.NotNil(deleted.Sighting) {
+		s.NotNil(deleted.Sighting.DeletedAt)
+	}
+
+	err = s.repo.Restore(s.ctx, id)
+	s.Require().NoError(err)
+	s.expectEvent(stream, model.SightingEventTypeRestored, id, 4)
+
+	err = s.repo.Delete(s.ctx, id, nil)
+	s.Require().NoError(err)
+	s.expectEvent(stream, model.SightingEventTypeDeleted, id, 5)
+
+	_, err = s.repo.Purge(s.ctx, time.Now().Add(time.Second))
+	s.Require().NoError(err)
+	s.expectEvent(stream, model.SightingEventTypePurged, id, 0)
+}
+
+func (s *UFORepositorySuite) TestWatchSkipsEarlierChanges() {
+	s.create(sightingInfo(time.Now()))
+
+	stream := s.watch("")
+
+	id := s.create(sightingInfo(time.Now()))
+	s.expectEvent(stream, model.SightingEventTypeCreated, id, 1)
+}
+
+func (s *UFORepositorySuite) TestWatchResume() {
+	stream := s.watch("")
+
+	first := s.create(sightingInfo(time.Now()))
+	firstEvent := s.expectEvent(stream, model.SightingEventTypeCreated, first, 1)
+
+	// Изменения, сделанные пока подписчик отключен, не теряются
+	s.Require().NoError(stream.Close(s.ctx))
+
+	second := s.create(sightingInfo(time.Now()))
+	third := s.create(sightingInfo(time.Now()))
+
+	resumed := s.watch(firstEvent.ResumeToken)
+	s.expectEvent(resumed, model.SightingEventTypeCreated, second, 1)
+	s.expectEvent(resumed, model.SightingEventTypeCreated, third, 1)
+}
+
+func (s *UFORepositorySuite) TestWatchInvalidResumeToken() {
+	_, err := s.repo.Watch(s.ctx, "@@@")
+	if errors.Is(err, model.ErrWatchNotSupported) {
+		s.T().Skip("watch is not supported by storage")
+	}
+	s.ErrorIs(err, model.ErrInvalidResumeToken)
+}
+
+func (s *UFORepositorySuite) TestWatchNextStopsOnContextCancel() {
+	stream := s.watch("")
+
+	ctx, cancel := context.WithTimeout(s.ctx, 100*time.Millisecond)
+	defer cancel()
+
+	_, err := stream.Next(ctx)
+	s.Error(err)
+}
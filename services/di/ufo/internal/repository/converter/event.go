@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"encoding/base64"
+	"slices"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// Типы операций change stream, на которые подписывается репозиторий
+const (
+	OperationTypeInsert  = "insert"
+	OperationTypeUpdate  = "update"
+	OperationTypeReplace = "replace"
+	OperationTypeDelete  = "delete"
+)
+
+const deletedAtField = "deleted_at"
+
+func SightingChangeToEvent(change repoModel.SightingChange) model.SightingEvent {
+	var sighting *model.Sighting
+	if change.FullDocument != nil {
+		tmp := SightingToModel(*change.FullDocument)
+		sighting = &tmp
+	}
+
+	occurredAt := time.Unix(int64(change.ClusterTime.T), 0)
+	if change.WallTime != nil {
+		occurredAt = *change.WallTime
+	}
+
+	return model.SightingEvent{
+		Type:        changeEventType(change),
+		Uuid:        change.DocumentKey.Uuid,
+		Sighting:    sighting,
+		OccurredAt:  occurredAt,
+		ResumeToken: base64.RawURLEncoding.EncodeToString(change.ResumeToken),
+	}
+}
+
+// changeEventType определяет тип события по операции: мягкое удаление и восстановление
+// в MongoDB - это обновления, которые выставляют или убирают поле deleted_at
+func changeEventType(change repoModel.SightingChange) model.SightingEventType {
+	switch change.OperationType {
+	case OperationTypeInsert:
+		return model.SightingEventTypeCreated
+	case OperationTypeDelete:
+		return model.SightingEventTypePurged
+	case OperationTypeReplace:
+		return model.SightingEventTypeUpdated
+	case OperationTypeUpdate:
+		if change.UpdateDescription != nil {
+			if _, ok := change.UpdateDescription.UpdatedFields[deletedAtField]; ok {
+				return model.SightingEventTypeDeleted
+			}
+			if slices.Contains(change.UpdateDescription.RemovedFields, deletedAtField) {
+				return model.SightingEventTypeRestored
+			}
+		}
+		return model.SightingEventTypeUpdated
+	default:
+		return model.SightingEventTypeUnspecified
+	}
+}
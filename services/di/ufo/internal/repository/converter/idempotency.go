@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func IdempotencyKeyToRepoModel(key model.IdempotencyKey) repoModel.IdempotencyKey {
+	return repoModel.IdempotencyKey{
+		Key:         key.Key,
+		RequestHash: key.RequestHash,
+		Uuid:        key.Uuid,
+		ExpiresAt:   key.ExpiresAt,
+	}
+}
+
+func IdempotencyKeyToModel(key repoModel.IdempotencyKey) model.IdempotencyKey {
+	return model.IdempotencyKey{
+		Key:         key.Key,
+		RequestHash: key.RequestHash,
+		Uuid:        key.Uuid,
+		ExpiresAt:   key.ExpiresAt,
+	}
+}
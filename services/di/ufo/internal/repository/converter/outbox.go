@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// NewOutboxEvent строит событие outbox по ревизии: событие публикуется о той же версии
+// наблюдения и несет тот же снимок. Событие готово к публикации сразу.
+func NewOutboxEvent(revision repoModel.SightingRevision) (repoModel.OutboxEvent, error) {
+	payload, err := json.Marshal(revision.Sighting)
+	if err != nil {
+		return repoModel.OutboxEvent{}, err
+	}
+
+	return repoModel.OutboxEvent{
+		Id:            uuid.Must(uuid.NewV7()).String(),
+		Type:          outboxEventTypeByAction(revision.Action),
+		SightingUuid:  revision.SightingUuid,
+		Version:       revision.Version,
+		Payload:       payload,
+		OccurredAt:    revision.OccurredAt,
+		NextAttemptAt: revision.OccurredAt,
+	}, nil
+}
+
+func OutboxEventsToModel(events []repoModel.OutboxEvent) []model.OutboxEvent {
+	result := make([]model.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, model.OutboxEvent{
+			Id:           event.Id,
+			Type:         outboxEventTypeToModel(event.Type),
+			SightingUuid: event.SightingUuid,
+			Version:      event.Version,
+			Payload:      event.Payload,
+			OccurredAt:   event.OccurredAt,
+			Attempts:     event.Attempts,
+		})
+	}
+
+	return result
+}
+
+func outboxEventTypeByAction(action string) string {
+	switch action {
+	case repoModel.RevisionActionCreated:
+		return repoModel.OutboxEventSightingCreated
+	case repoModel.RevisionActionUpdated:
+		return repoModel.OutboxEventSightingUpdated
+	case repoModel.RevisionActionDeleted:
+		return repoModel.OutboxEventSightingDeleted
+	case repoModel.RevisionActionRestored:
+		return repoModel.OutboxEventSightingRestored
+	default:
+		return ""
+	}
+}
+
+func outboxEventTypeToModel(eventType string) model.SightingEventType {
+	switch eventType {
+	case repoModel.OutboxEventSightingCreated:
+		return model.SightingEventTypeCreated
+	case repoModel.OutboxEventSightingUpdated:
+		return model.SightingEventTypeUpdated
+	case repoModel.OutboxEventSightingDeleted:
+		return model.SightingEventTypeDeleted
+	case repoModel.OutboxEventSightingRestored:
+		return model.SightingEventTypeRestored
+	default:
+		return model.SightingEventTypeUnspecified
+	}
+}
@@ -0,0 +1,115 @@
+package converter
+
+import (
+	"context"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/history"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// NewRevision строит ревизию изменения, после которого наблюдение стало after; before - nil для создания
+func NewRevision(ctx context.Context, action model.RevisionAction, before *repoModel.SightingInfo, after repoModel.Sighting, occurredAt time.Time) repoModel.SightingRevision {
+	var beforeInfo *model.SightingInfo
+	if before != nil {
+		info := SightingInfoToModel(*before)
+		beforeInfo = &info
+	}
+
+	return RevisionToRepoModel(history.NewRevision(ctx, action, beforeInfo, SightingToModel(after), occurredAt))
+}
+
+func RevisionToRepoModel(revision model.SightingRevision) repoModel.SightingRevision {
+	changes := make([]repoModel.FieldChange, 0, len(revision.Changes))
+	for _, change := range revision.Changes {
+		changes = append(changes, repoModel.FieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+
+	return repoModel.SightingRevision{
+		SightingUuid: revision.SightingUuid,
+		Version:      revision.Version,
+		Action:       revisionActionToRepoModel(revision.Action),
+		Actor:        revision.Actor,
+		OccurredAt:   revision.OccurredAt,
+		Changes:      changes,
+		Sighting:     SightingToRepoModel(revision.Sighting),
+	}
+}
+
+func RevisionsToModel(revisions []repoModel.SightingRevision) []model.SightingRevision {
+	result := make([]model.SightingRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		var changes []model.FieldChange
+		for _, change := range revision.Changes {
+			changes = append(changes, model.FieldChange{
+				Field:    change.Field,
+				OldValue: change.OldValue,
+				NewValue: change.NewValue,
+			})
+		}
+
+		result = append(result, model.SightingRevision{
+			SightingUuid: revision.SightingUuid,
+			Version:      revision.Version,
+			Action:       revisionActionToModel(revision.Action),
+			Actor:        revision.Actor,
+			OccurredAt:   revision.OccurredAt,
+			Changes:      changes,
+			Sighting:     SightingToModel(revision.Sighting),
+		})
+	}
+
+	return result
+}
+
+func SightingToRepoModel(sighting model.Sighting) repoModel.Sighting {
+	var attachments []repoModel.Attachment
+	for _, attachment := range sighting.Attachments {
+		attachments = append(attachments, AttachmentToRepoModel(attachment))
+	}
+
+	return repoModel.Sighting{
+		Uuid:        sighting.Uuid,
+		Info:        SightingInfoToRepoModel(sighting.Info),
+		CreatedAt:   sighting.CreatedAt,
+		UpdatedAt:   sighting.UpdatedAt,
+		DeletedAt:   sighting.DeletedAt,
+		Version:     sighting.Version,
+		Attachments: attachments,
+	}
+}
+
+func revisionActionToRepoModel(action model.RevisionAction) string {
+	switch action {
+	case model.RevisionActionCreated:
+		return repoModel.RevisionActionCreated
+	case model.RevisionActionUpdated:
+		return repoModel.RevisionActionUpdated
+	case model.RevisionActionDeleted:
+		return repoModel.RevisionActionDeleted
+	case model.RevisionActionRestored:
+		return repoModel.RevisionActionRestored
+	default:
+		return ""
+	}
+}
+
+func revisionActionToModel(action string) model.RevisionAction {
+	switch action {
+	case repoModel.RevisionActionCreated:
+		return model.RevisionActionCreated
+	case repoModel.RevisionActionUpdated:
+		return model.RevisionActionUpdated
+	case repoModel.RevisionActionDeleted:
+		return model.RevisionActionDeleted
+	case repoModel.RevisionActionRestored:
+		return model.RevisionActionRestored
+	default:
+		return model.RevisionActionUnspecified
+	}
+}
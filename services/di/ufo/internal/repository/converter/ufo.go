@@ -1,8 +1,12 @@
 package converter
 
 import (
+	"encoding/base64"
+	"encoding/json"
+
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/stats"
 )
 
 func SightingInfoToRepoModel(info model.SightingInfo) repoModel.SightingInfo {
@@ -13,19 +17,63 @@ func SightingInfoToRepoModel(info model.SightingInfo) repoModel.SightingInfo {
 		Color:           info.Color,
 		Sound:           info.Sound,
 		DurationSeconds: info.DurationSeconds,
+		Geo:             GeoPointToRepoModel(info.Coordinates),
 	}
 }
 
 func SightingToModel(sighting repoModel.Sighting) model.Sighting {
 	return model.Sighting{
-		Uuid:      sighting.Uuid,
-		Info:      SightingInfoToModel(sighting.Info),
-		CreatedAt: sighting.CreatedAt,
-		UpdatedAt: sighting.UpdatedAt,
-		DeletedAt: sighting.DeletedAt,
+		Uuid:        sighting.Uuid,
+		Info:        SightingInfoToModel(sighting.Info),
+		CreatedAt:   sighting.CreatedAt,
+		UpdatedAt:   sighting.UpdatedAt,
+		DeletedAt:   sighting.DeletedAt,
+		Version:     sighting.Version,
+		Attachments: AttachmentsToModel(sighting.Attachments),
+	}
+}
+
+func AttachmentToRepoModel(attachment model.Attachment) repoModel.Attachment {
+	return repoModel.Attachment{
+		Id:          attachment.Id,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		Sha256:      attachment.Sha256,
+		CreatedAt:   attachment.CreatedAt,
 	}
 }
 
+// AttachmentsToModel переводит вложения в доменную модель, пустой список становится nil
+func AttachmentsToModel(attachments []repoModel.Attachment) []model.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]model.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, model.Attachment{
+			Id:          attachment.Id,
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			SizeBytes:   attachment.SizeBytes,
+			Sha256:      attachment.Sha256,
+			CreatedAt:   attachment.CreatedAt,
+		})
+	}
+
+	return result
+}
+
+func SightingsToModel(sightings []repoModel.Sighting) []model.Sighting {
+	result := make([]model.Sighting, 0, len(sightings))
+	for _, sighting := range sightings {
+		result = append(result, SightingToModel(sighting))
+	}
+
+	return result
+}
+
 func SightingInfoToModel(info repoModel.SightingInfo) model.SightingInfo {
 	return model.SightingInfo{
 		ObservedAt:      info.ObservedAt,
@@ -34,5 +82,105 @@ func SightingInfoToModel(info repoModel.SightingInfo) model.SightingInfo {
 		Color:           info.Color,
 		Sound:           info.Sound,
 		DurationSeconds: info.DurationSeconds,
+		Coordinates:     GeoPointToModel(info.Geo),
+	}
+}
+
+// GeoPointToRepoModel переводит точку в GeoJSON, nil остается nil
+func GeoPointToRepoModel(point *model.GeoPoint) *repoModel.GeoJSONPoint {
+	if point == nil {
+		return nil
+	}
+
+	return &repoModel.GeoJSONPoint{
+		Type:        "Point",
+		Coordinates: []float64{point.Longitude, point.Latitude},
 	}
 }
+
+func GeoPointToModel(point *repoModel.GeoJSONPoint) *model.GeoPoint {
+	if point == nil || len(point.Coordinates) != 2 {
+		return nil
+	}
+
+	return &model.GeoPoint{
+		Latitude:  point.Coordinates[1],
+		Longitude: point.Coordinates[0],
+	}
+}
+
+func SightingToCursor(sighting repoModel.Sighting) repoModel.ListCursor {
+	return repoModel.ListCursor{
+		ObservedAt: sighting.Info.ObservedAt,
+		Uuid:       sighting.Uuid,
+	}
+}
+
+func CursorToPageToken(cursor repoModel.ListCursor) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func PageTokenToCursor(token string) (repoModel.ListCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return repoModel.ListCursor{}, model.ErrInvalidPageToken
+	}
+
+	var cursor repoModel.ListCursor
+	err = json.Unmarshal(raw, &cursor)
+	if err != nil || cursor.Uuid == "" {
+		return repoModel.ListCursor{}, model.ErrInvalidPageToken
+	}
+
+	return cursor, nil
+}
+
+func SearchHitsToModel(hits []repoModel.SightingSearchHit) []model.SightingSearchHit {
+	result := make([]model.SightingSearchHit, 0, len(hits))
+	for _, hit := range hits {
+		result = append(result, model.SightingSearchHit{
+			Sighting: SightingToModel(hit.Sighting),
+			Score:    hit.Score,
+		})
+	}
+
+	return result
+}
+
+func NearbySightingsToModel(sightings []repoModel.NearbySighting) []model.NearbySighting {
+	result := make([]model.NearbySighting, 0, len(sightings))
+	for _, sighting := range sightings {
+		result = append(result, model.NearbySighting{
+			Sighting:       SightingToModel(sighting.Sighting),
+			DistanceMeters: sighting.DistanceMeters,
+		})
+	}
+
+	return result
+}
+
+func StatsBucketsToModel(buckets []repoModel.StatsBucket, groupBy model.StatsGroupBy) []model.StatsBucket {
+	result := make([]model.StatsBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		modelBucket := model.StatsBucket{Count: bucket.Count}
+		switch groupBy {
+		case model.StatsGroupByColor:
+			modelBucket.Key = stats.ColorKey(bucket.Color)
+		case model.StatsGroupBySound:
+			modelBucket.Key = stats.SoundKey(bucket.Sound)
+		default:
+			if bucket.Start != nil {
+				start := bucket.Start.UTC()
+				modelBucket.Start = &start
+			}
+		}
+		result = append(result, modelBucket)
+	}
+
+	return result
+}
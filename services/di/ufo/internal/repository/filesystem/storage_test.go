@@ -0,0 +1,73 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func TestSaveAndOpen(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewStorage(t.TempDir())
+	require.NoError(t, err)
+
+	err = s.Save(ctx, "photo", strings.NewReader("содержимое"))
+	require.NoError(t, err)
+
+	f, err := s.Open(ctx, "photo")
+	require.NoError(t, err)
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, "содержимое", string(content))
+
+	require.NoError(t, s.Delete(ctx, "photo"))
+	_, err = s.Open(ctx, "photo")
+	require.ErrorIs(t, err, model.ErrAttachmentNotFound)
+
+	// Повторное удаление не ошибка
+	require.NoError(t, s.Delete(ctx, "photo"))
+}
+
+func TestSaveFailureLeavesNothing(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewStorage(dir)
+	require.NoError(t, err)
+
+	readErr := errors.New("stream broken")
+	err = s.Save(ctx, "photo", io.MultiReader(strings.NewReader("начало"), &failingReader{err: readErr}))
+	require.ErrorIs(t, err, readErr)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestInvalidID(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewStorage(t.TempDir())
+	require.NoError(t, err)
+
+	for _, id := range []string{"", "../photo", "a/b", ".hidden"} {
+		require.Error(t, s.Save(ctx, id, strings.NewReader("x")), id)
+
+		_, err = s.Open(ctx, id)
+		require.ErrorIs(t, err, model.ErrAttachmentNotFound, id)
+	}
+}
+
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read(_ []byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,54 @@
+package gridfs
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+)
+
+var _ def.AttachmentStorage = (*storage)(nil)
+
+// bucketName префикс коллекций GridFS: attachments.files и attachments.chunks
+const bucketName = "attachments"
+
+type storage struct {
+	bucket *mongo.GridFSBucket
+}
+
+func NewStorage(db *mongo.Database) *storage {
+	return &storage{
+		bucket: db.GridFSBucket(options.GridFSBucket().SetName(bucketName)),
+	}
+}
+
+func (s *storage) Save(ctx context.Context, id string, content io.Reader) error {
+	// При ошибке чтения драйвер прерывает загрузку и удаляет уже записанные части
+	return s.bucket.UploadFromStreamWithID(ctx, id, id, content)
+}
+
+func (s *storage) Open(ctx context.Context, id string) (io.ReadCloser, error) {
+	stream, err := s.bucket.OpenDownloadStream(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrFileNotFound) {
+			return nil, model.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+func (s *storage) Delete(ctx context.Context, id string) error {
+	err := s.bucket.Delete(ctx, id)
+	if errors.Is(err, mongo.ErrFileNotFound) {
+		return nil
+	}
+
+	return err
+}
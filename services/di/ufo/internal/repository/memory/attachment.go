@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+)
+
+func (r *repository) AddAttachment(ctx context.Context, uuid string, attachment model.Attachment) (model.Sighting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sighting, err := r.mutable(uuid, nil)
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	// Копия списка, чтобы не менять состояние, уже отданное в журнал событий
+	sighting.Attachments = append(slices.Clone(sighting.Attachments), repoConverter.AttachmentToRepoModel(attachment))
+
+	now := time.Now()
+	sighting.UpdatedAt = &now
+	sighting.Version++
+
+	// Новая версия попадает в историю и outbox, как при Update; поля наблюдения не меняются
+	err = r.record(repoConverter.NewRevision(ctx, model.RevisionActionUpdated, &sighting.Info, sighting, now))
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	r.data[uuid] = sighting
+	r.publish(model.SightingEventTypeUpdated, sighting, now)
+
+	return repoConverter.SightingToModel(sighting), nil
+}
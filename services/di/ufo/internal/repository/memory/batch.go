@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) BatchCreate(ctx context.Context, infos []model.SightingInfo) ([]model.SightingCreateResult, error) {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results := make([]model.SightingCreateResult, 0, len(infos))
+	for _, info := range infos {
+		newUUID := uuid.NewString()
+
+		sighting := repoModel.Sighting{
+			Uuid:      newUUID,
+			Info:      repoConverter.SightingInfoToRepoModel(info),
+			CreatedAt: now,
+			Version:   1,
+		}
+
+		err := r.record(repoConverter.NewRevision(ctx, model.RevisionActionCreated, nil, sighting, now))
+		if err != nil {
+			results = append(results, model.SightingCreateResult{Err: err})
+			continue
+		}
+
+		r.data[newUUID] = sighting
+		r.publish(model.SightingEventTypeCreated, sighting, now)
+
+		results = append(results, model.SightingCreateResult{Uuid: newUUID})
+	}
+
+	return results, nil
+}
+
+func (r *repository) BatchGet(_ context.Context, uuids []string) ([]model.Sighting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sightings := make([]model.Sighting, 0, len(uuids))
+	for _, uuid := range uuids {
+		sighting, ok := r.data[uuid]
+		if ok && sighting.DeletedAt == nil {
+			sightings = append(sightings, repoConverter.SightingToModel(sighting))
+		}
+	}
+
+	return sightings, nil
+}
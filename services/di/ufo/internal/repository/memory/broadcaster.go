@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// defaultHistorySize количество последних событий, от которых можно продолжить подписку
+const defaultHistorySize = 1024
+
+// broadcaster раздает события изменения наблюдений всем подписчикам процесса.
+// Последние события хранятся в журнале, поэтому переподключившийся подписчик
+// продолжает с позиции своего resume token, а медленный подписчик не блокирует запись.
+type broadcaster struct {
+	mu sync.Mutex
+	// history[i] имеет порядковый номер firstSeq+i
+	history  []model.SightingEvent
+	firstSeq uint64
+	lastSeq  uint64
+	limit    int
+	// changed закрывается и пересоздается при каждом новом событии
+	changed chan struct{}
+}
+
+func newBroadcaster(limit int) *broadcaster {
+	return &broadcaster{
+		firstSeq: 1,
+		limit:    limit,
+		changed:  make(chan struct{}),
+	}
+}
+
+// publish добавляет событие в журнал и будит ожидающих подписчиков
+func (b *broadcaster) publish(event model.SightingEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastSeq++
+	event.ResumeToken = strconv.FormatUint(b.lastSeq, 10)
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.limit {
+		drop := len(b.history) - b.limit
+		b.history = b.history[drop:]
+		b.firstSeq += uint64(drop)
+	}
+
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// subscribe возвращает подписку, начинающуюся сразу после события resumeToken
+// или, если токен пустой, после последнего опубликованного события
+func (b *broadcaster) subscribe(resumeToken string) (*subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	after := b.lastSeq
+	if resumeToken != "" {
+		seq, err := strconv.ParseUint(resumeToken, 10, 64)
+		if err != nil || seq > b.lastSeq {
+			return nil, model.ErrInvalidResumeToken
+		}
+
+		if seq+1 < b.firstSeq {
+			return nil, model.ErrResumeTokenExpired
+		}
+
+		after = seq
+	}
+
+	return &subscription{
+		broadcaster: b,
+		after:       after,
+	}, nil
+}
+
+// next возвращает событие, следующее за after, либо канал,
+// который закроется при появлении нового события
+func (b *broadcaster) next(after uint64) (model.SightingEvent, <-chan struct{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Подписчик отстал настолько, что нужные события уже вытеснены из журнала
+	if after+1 < b.firstSeq {
+		return model.SightingEvent{}, nil, model.ErrResumeTokenExpired
+	}
+
+	if after < b.lastSeq {
+		return b.history[after+1-b.firstSeq], nil, nil
+	}
+
+	return model.SightingEvent{}, b.changed, nil
+}
+
+var _ model.SightingEventStream = (*subscription)(nil)
+
+type subscription struct {
+	broadcaster *broadcaster
+	after       uint64
+}
+
+func (s *subscription) Next(ctx context.Context) (model.SightingEvent, error) {
+	for {
+		event, changed, err := s.broadcaster.next(s.after)
+		if err != nil {
+			return model.SightingEvent{}, err
+		}
+
+		if changed == nil {
+			s.after++
+			return event, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return model.SightingEvent{}, ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+func (s *subscription) Close(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func TestBroadcasterResumeTokenExpired(t *testing.T) {
+	b := newBroadcaster(2)
+
+	b.publish(model.SightingEvent{Uuid: "first"})
+	b.publish(model.SightingEvent{Uuid: "second"})
+	b.publish(model.SightingEvent{Uuid: "third"})
+
+	// Событие после "0" ("first") уже вытеснено из журнала
+	_, err := b.subscribe("0")
+	require.ErrorIs(t, err, model.ErrResumeTokenExpired)
+
+	sub, err := b.subscribe("1")
+	require.NoError(t, err)
+
+	event, err := sub.Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "second", event.Uuid)
+	require.Equal(t, "2", event.ResumeToken)
+}
+
+func TestBroadcasterLaggingSubscriber(t *testing.T) {
+	b := newBroadcaster(2)
+
+	sub, err := b.subscribe("")
+	require.NoError(t, err)
+
+	for range 3 {
+		b.publish(model.SightingEvent{})
+	}
+
+	_, err = sub.Next(context.Background())
+	require.ErrorIs(t, err, model.ErrResumeTokenExpired)
+}
+
+func TestBroadcasterTokenFromFuture(t *testing.T) {
+	b := newBroadcaster(2)
+
+	_, err := b.subscribe("1")
+	require.ErrorIs(t, err, model.ErrInvalidResumeToken)
+}
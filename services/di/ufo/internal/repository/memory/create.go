@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Create(ctx context.Context, info model.SightingInfo) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.create(ctx, info)
+}
+
+func (r *repository) CreateIdempotent(ctx context.Context, info model.SightingInfo, key model.IdempotencyKey) (model.IdempotencyKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.idempotencyKeys[key.Key]
+	if ok && existing.ExpiresAt.After(time.Now()) {
+		return repoConverter.IdempotencyKeyToModel(existing), nil
+	}
+
+	newUUID, err := r.create(ctx, info)
+	if err != nil {
+		return model.IdempotencyKey{}, err
+	}
+
+	key.Uuid = newUUID
+	r.idempotencyKeys[key.Key] = repoConverter.IdempotencyKeyToRepoModel(key)
+
+	return key, nil
+}
+
+// create сохраняет новое наблюдение. Вызывается под блокировкой.
+func (r *repository) create(ctx context.Context, info model.SightingInfo) (string, error) {
+	newUUID := uuid.NewString()
+	now := time.Now()
+
+	sighting := repoModel.Sighting{
+		Uuid:      newUUID,
+		Info:      repoConverter.SightingInfoToRepoModel(info),
+		CreatedAt: now,
+		Version:   1,
+	}
+
+	err := r.record(repoConverter.NewRevision(ctx, model.RevisionActionCreated, nil, sighting, now))
+	if err != nil {
+		return "", err
+	}
+
+	r.data[newUUID] = sighting
+	r.publish(model.SightingEventTypeCreated, sighting, now)
+
+	return newUUID, nil
+}
@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Delete(ctx context.Context, uuid string, expectedVersion *int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sighting, err := r.mutable(uuid, expectedVersion)
+	if err != nil {
+		return err
+	}
+
+	return r.delete(ctx, sighting, time.Now())
+}
+
+// delete мягко удаляет наблюдение, полученное из mutable. Вызывается под блокировкой.
+func (r *repository) delete(ctx context.Context, sighting repoModel.Sighting, now time.Time) error {
+	// Мягкое удаление - устанавливаем deleted_at
+	sighting.DeletedAt = &now
+	sighting.Version++
+
+	err := r.record(repoConverter.NewRevision(ctx, model.RevisionActionDeleted, nil, sighting, now))
+	if err != nil {
+		return err
+	}
+
+	r.data[sighting.Uuid] = sighting
+	r.publish(model.SightingEventTypeDeleted, sighting, now)
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, uuid string) (model.Sighting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	repoSighting, ok := r.data[uuid]
+	if !ok {
+		return model.Sighting{}, model.ErrSightingNotFound
+	}
+
+	if repoSighting.DeletedAt != nil {
+		return model.Sighting{}, model.ErrSightingDeleted
+	}
+
+	return repoConverter.SightingToModel(repoSighting), nil
+}
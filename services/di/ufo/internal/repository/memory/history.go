@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) History(_ context.Context, uuid string) ([]model.SightingRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions, ok := r.revisions[uuid]
+	if !ok {
+		if _, exists := r.data[uuid]; !exists {
+			return nil, model.ErrSightingNotFound
+		}
+	}
+
+	return repoConverter.RevisionsToModel(revisions), nil
+}
+
+// record добавляет ревизию в историю наблюдения и событие о ней в outbox. Вызывается
+// под блокировкой до применения изменения: при ошибке наблюдение остается прежним,
+// а ревизии идут строго по версиям.
+func (r *repository) record(revision repoModel.SightingRevision) error {
+	event, err := repoConverter.NewOutboxEvent(revision)
+	if err != nil {
+		return err
+	}
+
+	r.revisions[revision.SightingUuid] = append(r.revisions[revision.SightingUuid], revision)
+	r.outbox = append(r.outbox, event)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) List(_ context.Context, query model.SightingListQuery) (model.SightingList, error) {
+	var cursor *repoModel.ListCursor
+	if query.PageToken != "" {
+		parsed, err := repoConverter.PageTokenToCursor(query.PageToken)
+		if err != nil {
+			return model.SightingList{}, err
+		}
+		cursor = &parsed
+	}
+
+	r.mu.RLock()
+	repoSightings := make([]repoModel.Sighting, 0, len(r.data))
+	for _, sighting := range r.data {
+		if matchesFilter(sighting, query.Filter) && (cursor == nil || compareToCursor(sighting, *cursor) > 0) {
+			repoSightings = append(repoSightings, sighting)
+		}
+	}
+	r.mu.RUnlock()
+
+	slices.SortFunc(repoSightings, func(a, b repoModel.Sighting) int {
+		return compareToCursor(a, repoConverter.SightingToCursor(b))
+	})
+
+	var nextPageToken string
+	if len(repoSightings) > int(query.PageSize) {
+		repoSightings = repoSightings[:query.PageSize]
+
+		var err error
+		nextPageToken, err = repoConverter.CursorToPageToken(
+			repoConverter.SightingToCursor(repoSightings[len(repoSightings)-1]),
+		)
+		if err != nil {
+			return model.SightingList{}, err
+		}
+	}
+
+	return model.SightingList{
+		Sightings:     repoConverter.SightingsToModel(repoSightings),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func matchesFilter(sighting repoModel.Sighting, filter model.SightingFilter) bool {
+	info := sighting.Info
+
+	switch {
+	case !filter.IncludeDeleted && sighting.DeletedAt != nil:
+		return false
+	case filter.ObservedFrom != nil && (info.ObservedAt == nil || info.ObservedAt.Before(*filter.ObservedFrom)):
+		return false
+	case filter.ObservedTo != nil && (info.ObservedAt == nil || !info.ObservedAt.Before(*filter.ObservedTo)):
+		return false
+	case filter.Location != nil && !strings.Contains(strings.ToLower(info.Location), strings.ToLower(*filter.Location)):
+		return false
+	case filter.Color != nil && (info.Color == nil || *info.Color != *filter.Color):
+		return false
+	case filter.Sound != nil && (info.Sound == nil || *info.Sound != *filter.Sound):
+		return false
+	}
+
+	return true
+}
+
+// compareToCursor сравнивает наблюдение с позицией курсора в порядке выдачи
+// (observed_at DESC, uuid DESC, наблюдения без observed_at в конце):
+// отрицательное значение - наблюдение идет раньше курсора, положительное - позже
+func compareToCursor(sighting repoModel.Sighting, cursor repoModel.ListCursor) int {
+	observedAt := sighting.Info.ObservedAt
+
+	switch {
+	case observedAt == nil && cursor.ObservedAt != nil:
+		return 1
+	case observedAt != nil && cursor.ObservedAt == nil:
+		return -1
+	case observedAt != nil && !observedAt.Equal(*cursor.ObservedAt):
+		return cursor.ObservedAt.Compare(*observedAt)
+	}
+
+	return strings.Compare(cursor.Uuid, sighting.Uuid)
+}
@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Merge(
+	ctx context.Context,
+	targetUuid string,
+	updateInfo model.SightingUpdateInfo,
+	expectedVersion *int64,
+	duplicateUuids []string,
+) (model.Sighting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Сначала проверяем все наблюдения, чтобы при ошибке ничего не изменить
+	target, err := r.mutable(targetUuid, expectedVersion)
+	if err != nil {
+		return model.Sighting{}, fmt.Errorf("sighting %s: %w", targetUuid, err)
+	}
+
+	duplicates := make([]repoModel.Sighting, 0, len(duplicateUuids))
+	for _, uuid := range duplicateUuids {
+		duplicate, merr := r.mutable(uuid, nil)
+		if merr != nil {
+			return model.Sighting{}, fmt.Errorf("sighting %s: %w", uuid, merr)
+		}
+		duplicates = append(duplicates, duplicate)
+	}
+
+	now := time.Now()
+
+	merged, err := r.update(ctx, target, updateInfo, now)
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	for _, duplicate := range duplicates {
+		err = r.delete(ctx, duplicate, now)
+		if err != nil {
+			return model.Sighting{}, err
+		}
+	}
+
+	return repoConverter.SightingToModel(merged), nil
+}
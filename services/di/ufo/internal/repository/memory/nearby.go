@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/geo"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) FindNearby(_ context.Context, query model.NearbyQuery) ([]model.NearbySighting, error) {
+	r.mu.RLock()
+	var matched []repoModel.NearbySighting
+	for _, sighting := range r.data {
+		if sighting.DeletedAt != nil {
+			continue
+		}
+
+		point := repoConverter.GeoPointToModel(sighting.Info.Geo)
+		if point == nil {
+			continue
+		}
+
+		distance := geo.Distance(query.Center, *point)
+		if distance <= query.RadiusMeters {
+			matched = append(matched, repoModel.NearbySighting{Sighting: sighting, DistanceMeters: distance})
+		}
+	}
+	r.mu.RUnlock()
+
+	slices.SortFunc(matched, func(a, b repoModel.NearbySighting) int {
+		if c := cmp.Compare(a.DistanceMeters, b.DistanceMeters); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Sighting.Uuid, b.Sighting.Uuid)
+	})
+
+	if len(matched) > int(query.Limit) {
+		matched = matched[:query.Limit]
+	}
+
+	return repoConverter.NearbySightingsToModel(matched), nil
+}
@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) PendingEvents(_ context.Context, now time.Time, limit int) ([]model.OutboxEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var pending []repoModel.OutboxEvent
+	for _, event := range r.outbox {
+		if len(pending) == limit {
+			break
+		}
+
+		if !event.NextAttemptAt.After(now) {
+			pending = append(pending, event)
+		}
+	}
+
+	return repoConverter.OutboxEventsToModel(pending), nil
+}
+
+func (r *repository) AckEvent(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.outbox = slices.DeleteFunc(r.outbox, func(event repoModel.OutboxEvent) bool {
+		return event.Id == id
+	})
+
+	return nil
+}
+
+func (r *repository) RetryEvent(_ context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := slices.IndexFunc(r.outbox, func(event repoModel.OutboxEvent) bool {
+		return event.Id == id
+	})
+	if i < 0 {
+		return nil
+	}
+
+	r.outbox[i].Attempts++
+	r.outbox[i].NextAttemptAt = nextAttemptAt
+	r.outbox[i].LastError = lastError
+
+	return nil
+}
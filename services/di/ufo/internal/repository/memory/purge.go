@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (r *repository) Purge(_ context.Context, deletedBefore time.Time) (model.PurgeResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	var result model.PurgeResult
+	for uuid, sighting := range r.data {
+		if sighting.DeletedAt != nil && sighting.DeletedAt.Before(deletedBefore) {
+			delete(r.data, uuid)
+			result.PurgedCount++
+			for _, attachment := range sighting.Attachments {
+				result.AttachmentIds = append(result.AttachmentIds, attachment.Id)
+			}
+
+			r.events.publish(model.SightingEvent{
+				Type:       model.SightingEventTypePurged,
+				Uuid:       uuid,
+				OccurredAt: now,
+			})
+		}
+	}
+
+	// Заодно удаляем истекшие ключи идемпотентности, чтобы они не копились в памяти
+	for key, idempotencyKey := range r.idempotencyKeys {
+		if !idempotencyKey.ExpiresAt.After(now) {
+			delete(r.idempotencyKeys, key)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+var (
+	_ def.UFORepository    = (*repository)(nil)
+	_ def.OutboxRepository = (*repository)(nil)
+)
+
+// repository хранит наблюдения в памяти процесса. Семантика (мягкое удаление,
+// версии, порядок выдачи List) повторяет реализации на MongoDB и PostgreSQL.
+type repository struct {
+	mu        sync.RWMutex
+	data      map[string]repoModel.Sighting
+	revisions map[string][]repoModel.SightingRevision
+	// outbox в порядке записи событий
+	outbox          []repoModel.OutboxEvent
+	idempotencyKeys map[string]repoModel.IdempotencyKey
+	events          *broadcaster
+}
+
+func NewRepository() *repository {
+	return &repository{
+		data:            make(map[string]repoModel.Sighting),
+		revisions:       make(map[string][]repoModel.SightingRevision),
+		idempotencyKeys: make(map[string]repoModel.IdempotencyKey),
+		events:          newBroadcaster(defaultHistorySize),
+	}
+}
+
+// mutable возвращает неудаленное наблюдение в ожидаемой версии
+// или ошибку, объясняющую, почему его нельзя изменить. Вызывается под блокировкой.
+func (r *repository) mutable(uuid string, expectedVersion *int64) (repoModel.Sighting, error) {
+	sighting, ok := r.data[uuid]
+	if !ok {
+		return repoModel.Sighting{}, model.ErrSightingNotFound
+	}
+
+	if sighting.DeletedAt != nil {
+		return repoModel.Sighting{}, model.ErrSightingDeleted
+	}
+
+	if expectedVersion != nil && sighting.Version != *expectedVersion {
+		return repoModel.Sighting{}, model.ErrVersionConflict
+	}
+
+	return sighting, nil
+}
@@ -0,0 +1,16 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/contract"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	suite.Run(t, contract.NewUFORepositorySuite(func(*testing.T) def.UFORepository {
+		return NewRepository()
+	}))
+}
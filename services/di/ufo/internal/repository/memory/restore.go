@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+)
+
+func (r *repository) Restore(ctx context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sighting, ok := r.data[uuid]
+	if !ok {
+		return model.ErrSightingNotFound
+	}
+
+	if sighting.DeletedAt == nil {
+		return model.ErrSightingNotDeleted
+	}
+
+	sighting.DeletedAt = nil
+	now := time.Now()
+	sighting.UpdatedAt = &now
+	sighting.Version++
+
+	err := r.record(repoConverter.NewRevision(ctx, model.RevisionActionRestored, nil, sighting, now))
+	if err != nil {
+		return err
+	}
+
+	r.data[uuid] = sighting
+	r.publish(model.SightingEventTypeRestored, sighting, now)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/search"
+)
+
+// Веса полей совпадают с весами текстового индекса MongoDB
+const (
+	locationWeight    = 2
+	descriptionWeight = 1
+)
+
+func (r *repository) Search(_ context.Context, query model.SightingSearchQuery) ([]model.SightingSearchHit, error) {
+	terms := make(map[string]struct{}, len(query.Terms))
+	for _, term := range query.Terms {
+		terms[term] = struct{}{}
+	}
+
+	type scored struct {
+		sighting repoModel.Sighting
+		score    int
+	}
+
+	r.mu.RLock()
+	var matched []scored
+	for _, sighting := range r.data {
+		if sighting.DeletedAt != nil {
+			continue
+		}
+
+		score := locationWeight*countTerms(sighting.Info.Location, terms) +
+			descriptionWeight*countTerms(sighting.Info.Description, terms)
+		if score > 0 {
+			matched = append(matched, scored{sighting: sighting, score: score})
+		}
+	}
+	r.mu.RUnlock()
+
+	slices.SortFunc(matched, func(a, b scored) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.sighting.Uuid, b.sighting.Uuid)
+	})
+
+	if len(matched) > int(query.Limit) {
+		matched = matched[:query.Limit]
+	}
+
+	hits := make([]model.SightingSearchHit, 0, len(matched))
+	for _, m := range matched {
+		hits = append(hits, model.SightingSearchHit{
+			Sighting: repoConverter.SightingToModel(m.sighting),
+			Score:    float64(m.score),
+		})
+	}
+
+	return hits, nil
+}
+
+// countTerms считает вхождения искомых слов в тексте
+func countTerms(text string, terms map[string]struct{}) int {
+	count := 0
+	for _, term := range search.Tokenize(text) {
+		if _, ok := terms[term]; ok {
+			count++
+		}
+	}
+
+	return count
+}
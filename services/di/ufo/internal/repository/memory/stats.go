@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/stats"
+)
+
+func (r *repository) Stats(_ context.Context, query model.StatsQuery) ([]model.StatsBucket, error) {
+	counts := make(map[string]int64)
+	starts := make(map[string]time.Time)
+
+	r.mu.RLock()
+	for _, sighting := range r.data {
+		if sighting.DeletedAt != nil {
+			continue
+		}
+
+		observedAt := sighting.Info.ObservedAt
+		timeFiltered := query.ObservedFrom != nil || query.ObservedTo != nil || query.GroupBy.IsTime()
+		if timeFiltered && observedAt == nil {
+			continue
+		}
+		if query.ObservedFrom != nil && observedAt.Before(*query.ObservedFrom) {
+			continue
+		}
+		if query.ObservedTo != nil && !observedAt.Before(*query.ObservedTo) {
+			continue
+		}
+
+		var key string
+		switch query.GroupBy {
+		case model.StatsGroupByColor:
+			key = stats.ColorKey(sighting.Info.Color)
+		case model.StatsGroupBySound:
+			key = stats.SoundKey(sighting.Info.Sound)
+		default:
+			start := stats.Truncate(*observedAt, query.GroupBy)
+			key = stats.Key(start, query.GroupBy)
+			starts[key] = start
+		}
+		counts[key]++
+	}
+	r.mu.RUnlock()
+
+	buckets := make([]model.StatsBucket, 0, len(counts))
+	for key, count := range counts {
+		bucket := model.StatsBucket{Count: count}
+		if start, ok := starts[key]; ok {
+			bucket.Start = &start
+		} else {
+			bucket.Key = key
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, nil
+}
@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo, expectedVersion *int64) (model.Sighting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sighting, err := r.mutable(uuid, expectedVersion)
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	updated, err := r.update(ctx, sighting, updateInfo, time.Now())
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	return repoConverter.SightingToModel(updated), nil
+}
+
+// update применяет изменения к наблюдению, полученному из mutable, и сохраняет его. Вызывается под блокировкой.
+func (r *repository) update(ctx context.Context, sighting repoModel.Sighting, updateInfo model.SightingUpdateInfo, now time.Time) (repoModel.Sighting, error) {
+	before := sighting.Info
+
+	// Обновляем поля, только если они были установлены в запросе
+	if updateInfo.ObservedAt != nil {
+		sighting.Info.ObservedAt = updateInfo.ObservedAt
+	}
+
+	if updateInfo.Location != nil {
+		sighting.Info.Location = *updateInfo.Location
+	}
+
+	if updateInfo.Description != nil {
+		sighting.Info.Description = *updateInfo.Description
+	}
+
+	if updateInfo.Color != nil {
+		sighting.Info.Color = updateInfo.Color
+	}
+
+	if updateInfo.Sound != nil {
+		sighting.Info.Sound = updateInfo.Sound
+	}
+
+	if updateInfo.DurationSeconds != nil {
+		sighting.Info.DurationSeconds = updateInfo.DurationSeconds
+	}
+
+	if updateInfo.Coordinates != nil {
+		sighting.Info.Geo = repoConverter.GeoPointToRepoModel(updateInfo.Coordinates)
+	}
+
+	for _, field := range updateInfo.Clear {
+		switch field {
+		case model.SightingFieldObservedAt:
+			sighting.Info.ObservedAt = nil
+		case model.SightingFieldColor:
+			sighting.Info.Color = nil
+		case model.SightingFieldSound:
+			sighting.Info.Sound = nil
+		case model.SightingFieldDurationSeconds:
+			sighting.Info.DurationSeconds = nil
+		case model.SightingFieldCoordinates:
+			sighting.Info.Geo = nil
+		}
+	}
+
+	sighting.UpdatedAt = &now
+	sighting.Version++
+
+	err := r.record(repoConverter.NewRevision(ctx, model.RevisionActionUpdated, &before, sighting, now))
+	if err != nil {
+		return repoModel.Sighting{}, err
+	}
+
+	r.data[sighting.Uuid] = sighting
+	r.publish(model.SightingEventTypeUpdated, sighting, now)
+
+	return sighting, nil
+}
@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Watch(_ context.Context, resumeToken string) (model.SightingEventStream, error) {
+	return r.events.subscribe(resumeToken)
+}
+
+// publish сообщает подписчикам об изменении наблюдения.
+// Вызывается под блокировкой записи, поэтому порядок событий совпадает с порядком изменений.
+func (r *repository) publish(eventType model.SightingEventType, sighting repoModel.Sighting, occurredAt time.Time) {
+	modelSighting := repoConverter.SightingToModel(sighting)
+
+	r.events.publish(model.SightingEvent{
+		Type:       eventType,
+		Uuid:       sighting.Uuid,
+		Sighting:   &modelSighting,
+		OccurredAt: occurredAt,
+	})
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// IdempotencyKey документ коллекции idempotency_keys (строка таблицы в PostgreSQL)
+type IdempotencyKey struct {
+	Key         string    `bson:"_id"`
+	RequestHash string    `bson:"request_hash"`
+	Uuid        string    `bson:"uuid"`
+	ExpiresAt   time.Time `bson:"expires_at"`
+	// Pending ключ занят, но наблюдение еще не сохранено (MongoDB без транзакций)
+	Pending bool `bson:"pending,omitempty"`
+}
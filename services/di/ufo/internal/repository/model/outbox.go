@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// Типы событий outbox хранятся строками: под этими именами события и публикуются
+const (
+	OutboxEventSightingCreated  = "SightingCreated"
+	OutboxEventSightingUpdated  = "SightingUpdated"
+	OutboxEventSightingDeleted  = "SightingDeleted"
+	OutboxEventSightingRestored = "SightingRestored"
+)
+
+// OutboxEvent документ коллекции outbox (строка таблицы в PostgreSQL).
+// Id - UUIDv7, поэтому порядок идентификаторов совпадает с порядком записи.
+type OutboxEvent struct {
+	Id            string    `bson:"_id"`
+	Type          string    `bson:"type"`
+	SightingUuid  string    `bson:"sighting_uuid"`
+	Version       int64     `bson:"version"`
+	Payload       []byte    `bson:"payload"`
+	OccurredAt    time.Time `bson:"occurred_at"`
+	Attempts      int       `bson:"attempts"`
+	NextAttemptAt time.Time `bson:"next_attempt_at"`
+	LastError     string    `bson:"last_error,omitempty"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// Операции ревизий хранятся строками, чтобы записи читались без знания кодов enum
+const (
+	RevisionActionCreated  = "created"
+	RevisionActionUpdated  = "updated"
+	RevisionActionDeleted  = "deleted"
+	RevisionActionRestored = "restored"
+)
+
+// SightingRevision документ коллекции sighting_revisions (строка таблицы в PostgreSQL)
+type SightingRevision struct {
+	SightingUuid string        `bson:"sighting_uuid"`
+	Version      int64         `bson:"version"`
+	Action       string        `bson:"action"`
+	Actor        string        `bson:"actor,omitempty"`
+	OccurredAt   time.Time     `bson:"occurred_at"`
+	Changes      []FieldChange `bson:"changes,omitempty"`
+	// Sighting снимок наблюдения после изменения
+	Sighting Sighting `bson:"sighting"`
+}
+
+type FieldChange struct {
+	Field    string  `bson:"field" json:"field"`
+	OldValue *string `bson:"old_value,omitempty" json:"old_value,omitempty"`
+	NewValue *string `bson:"new_value,omitempty" json:"new_value,omitempty"`
+}
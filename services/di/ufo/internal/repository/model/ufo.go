@@ -1,14 +1,20 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
 
 type SightingInfo struct {
-	ObservedAt      *time.Time `bson:"observed_at,omitempty"`
-	Location        string     `bson:"location"`
-	Description     string     `bson:"description"`
-	Color           *string    `bson:"color,omitempty"`
-	Sound           *bool      `bson:"sound,omitempty"`
-	DurationSeconds *int32     `bson:"duration_seconds,omitempty"`
+	ObservedAt      *time.Time `bson:"observed_at,omitempty" json:"observed_at,omitempty"`
+	Location        string     `bson:"location" json:"location"`
+	Description     string     `bson:"description" json:"description"`
+	Color           *string    `bson:"color,omitempty" json:"color,omitempty"`
+	Sound           *bool      `bson:"sound,omitempty" json:"sound,omitempty"`
+	DurationSeconds *int32     `bson:"duration_seconds,omitempty" json:"duration_seconds,omitempty"`
+	// Geo координаты в формате GeoJSON, по ним строится 2dsphere индекс
+	Geo *GeoJSONPoint `bson:"geo,omitempty" json:"geo,omitempty"`
 }
 
 type SightingUpdateInfo struct {
@@ -20,10 +26,79 @@ type SightingUpdateInfo struct {
 	DurationSeconds *int32     `bson:"duration_seconds,omitempty"`
 }
 
+// GeoJSONPoint точка GeoJSON: в Coordinates сначала долгота, затем широта
+type GeoJSONPoint struct {
+	Type        string    `bson:"type" json:"type"`
+	Coordinates []float64 `bson:"coordinates" json:"coordinates"`
+}
+
+// Sighting в PostgreSQL хранится колонками, а в JSON - только как снимок в ревизии и в событии outbox
 type Sighting struct {
-	Uuid      string       `bson:"_id"`
-	Info      SightingInfo `bson:"info"`
-	CreatedAt time.Time    `bson:"created_at"`
-	UpdatedAt *time.Time   `bson:"updated_at,omitempty"`
-	DeletedAt *time.Time   `bson:"deleted_at,omitempty"`
+	Uuid      string       `bson:"_id" json:"uuid"`
+	Info      SightingInfo `bson:"info" json:"info"`
+	CreatedAt time.Time    `bson:"created_at" json:"created_at"`
+	UpdatedAt *time.Time   `bson:"updated_at,omitempty" json:"updated_at,omitempty"`
+	DeletedAt *time.Time   `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	Version   int64        `bson:"version" json:"version"`
+	// Attachments хранится массивом в документе MongoDB и jsonb колонкой в PostgreSQL
+	Attachments []Attachment `bson:"attachments,omitempty" json:"attachments,omitempty"`
+}
+
+type Attachment struct {
+	Id          string    `bson:"id" json:"id"`
+	FileName    string    `bson:"file_name" json:"file_name"`
+	ContentType string    `bson:"content_type" json:"content_type"`
+	SizeBytes   int64     `bson:"size_bytes" json:"size_bytes"`
+	Sha256      string    `bson:"sha256" json:"sha256"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
+}
+
+// SightingSearchHit наблюдение вместе с релевантностью из текстового индекса
+type SightingSearchHit struct {
+	Sighting `bson:",inline"`
+	Score    float64 `bson:"score"`
+}
+
+// NearbySighting наблюдение вместе с расстоянием, вычисленным $geoNear
+type NearbySighting struct {
+	Sighting       `bson:",inline"`
+	DistanceMeters float64 `bson:"distance_meters"`
+}
+
+// ListCursor позиция последнего наблюдения на странице,
+// от которой продолжается выборка (сортировка по observed_at и _id по убыванию)
+type ListCursor struct {
+	ObservedAt *time.Time `json:"observed_at,omitempty"`
+	Uuid       string     `json:"uuid"`
+}
+
+// SightingChange событие потока изменений (change stream) коллекции наблюдений
+type SightingChange struct {
+	// ResumeToken идентификатор события, от которого можно продолжить поток
+	ResumeToken       bson.Raw                   `bson:"_id"`
+	OperationType     string                     `bson:"operationType"`
+	DocumentKey       SightingChangeKey          `bson:"documentKey"`
+	FullDocument      *Sighting                  `bson:"fullDocument"`
+	UpdateDescription *SightingUpdateDescription `bson:"updateDescription"`
+	ClusterTime       bson.Timestamp             `bson:"clusterTime"`
+	// WallTime заполняется начиная с MongoDB 6.0
+	WallTime *time.Time `bson:"wallTime"`
+}
+
+type SightingChangeKey struct {
+	Uuid string `bson:"_id"`
+}
+
+type SightingUpdateDescription struct {
+	UpdatedFields map[string]any `bson:"updatedFields"`
+	RemovedFields []string       `bson:"removedFields"`
+}
+
+// StatsBucket группа статистики; заполнено одно из полей Start, Color или Sound
+// в зависимости от измерения группировки (nil, если значение не указано)
+type StatsBucket struct {
+	Start *time.Time `bson:"start"`
+	Color *string    `bson:"color"`
+	Sound *bool      `bson:"sound"`
+	Count int64      `bson:"count"`
 }
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) AddAttachment(ctx context.Context, uuid string, attachment model.Attachment) (model.Sighting, error) {
+	if !isValidUUID(uuid) {
+		return model.Sighting{}, model.ErrSightingNotFound
+	}
+
+	// Вложение дописывается в конец jsonb массива тем же запросом, что проверяет наблюдение
+	added := []repoModel.Attachment{repoConverter.AttachmentToRepoModel(attachment)}
+
+	now := time.Now()
+	query, args, err := builder().
+		Update(tableName).
+		Set("attachments", sq.Expr("attachments || ?::jsonb", added)).
+		Set("updated_at", now).
+		Set("version", sq.Expr("version + 1")).
+		Where(mutableWhere(uuid, nil)).
+		Suffix("RETURNING " + strings.Join(sightingColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	var updated repoModel.Sighting
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var serr error
+		updated, serr = scanSighting(tx.QueryRow(ctx, query, args...))
+		if serr != nil {
+			return serr
+		}
+
+		// Новая версия попадает в историю и outbox, как при Update; поля наблюдения не меняются
+		return record(ctx, tx, repoConverter.NewRevision(ctx, model.RevisionActionUpdated, &updated.Info, updated, now))
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Sighting{}, r.missReason(ctx, uuid)
+		}
+		return model.Sighting{}, err
+	}
+
+	return repoConverter.SightingToModel(updated), nil
+}
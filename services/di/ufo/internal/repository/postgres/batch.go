@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// BatchCreate вставляет наблюдения одним запросом в одной транзакции с их ревизиями
+// и событиями outbox:
+// при ошибке не создается ни одно наблюдение
+func (r *repository) BatchCreate(ctx context.Context, infos []model.SightingInfo) ([]model.SightingCreateResult, error) {
+	now := time.Now()
+
+	insert := builder().
+		Insert(tableName).
+		Columns(insertColumns...)
+
+	results := make([]model.SightingCreateResult, 0, len(infos))
+	for _, info := range infos {
+		newUUID := uuid.NewString()
+
+		insert = insert.Values(insertValues(newUUID, info, now)...)
+		results = append(results, model.SightingCreateResult{Uuid: newUUID})
+	}
+
+	query, args, err := insert.
+		Suffix("RETURNING " + strings.Join(sightingColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, qerr := tx.Query(ctx, query, args...)
+		if qerr != nil {
+			return qerr
+		}
+
+		revisions := make([]repoModel.SightingRevision, 0, len(infos))
+		for rows.Next() {
+			created, serr := scanSighting(rows)
+			if serr != nil {
+				rows.Close()
+				return serr
+			}
+			revisions = append(revisions, repoConverter.NewRevision(ctx, model.RevisionActionCreated, nil, created, now))
+		}
+		rows.Close()
+
+		qerr = rows.Err()
+		if qerr != nil {
+			return qerr
+		}
+
+		return record(ctx, tx, revisions...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *repository) BatchGet(ctx context.Context, uuids []string) ([]model.Sighting, error) {
+	validUUIDs := make([]string, 0, len(uuids))
+	for _, id := range uuids {
+		if isValidUUID(id) {
+			validUUIDs = append(validUUIDs, id)
+		}
+	}
+
+	if len(validUUIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := builder().
+		Select(sightingColumns...).
+		From(tableName).
+		Where(sq.Eq{
+			"uuid":       validUUIDs,
+			"deleted_at": nil,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var repoSightings []repoModel.Sighting
+	for rows.Next() {
+		sighting, scanErr := scanSighting(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		repoSightings = append(repoSightings, sighting)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.SightingsToModel(repoSightings), nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+const idempotencyKeysTableName = "idempotency_keys"
+
+func (r *repository) Create(ctx context.Context, info model.SightingInfo) (string, error) {
+	newUUID := uuid.NewString()
+
+	// Наблюдение, его первая ревизия и событие outbox сохраняются в одной транзакции
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return insert(ctx, tx, newUUID, info)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return newUUID, nil
+}
+
+func (r *repository) CreateIdempotent(ctx context.Context, info model.SightingInfo, key model.IdempotencyKey) (model.IdempotencyKey, error) {
+	key.Uuid = uuid.NewString()
+
+	// Занимаем ключ; истекшую запись перезаписываем. Параллельная вставка того же ключа
+	// ждет фиксации этой транзакции и после нее видит занятый ключ.
+	claim, claimArgs, err := builder().
+		Insert(idempotencyKeysTableName).
+		Columns("key", "request_hash", "uuid", "expires_at").
+		Values(key.Key, key.RequestHash, key.Uuid, key.ExpiresAt).
+		Suffix(`ON CONFLICT (key) DO UPDATE
+			SET request_hash = excluded.request_hash, uuid = excluded.uuid, expires_at = excluded.expires_at
+			WHERE `+idempotencyKeysTableName+`.expires_at <= ?
+			RETURNING key`, time.Now()).
+		ToSql()
+	if err != nil {
+		return model.IdempotencyKey{}, err
+	}
+
+	existing, existingArgs, err := builder().
+		Select("key", "request_hash", "uuid", "expires_at").
+		From(idempotencyKeysTableName).
+		Where(sq.Eq{"key": key.Key}).
+		ToSql()
+	if err != nil {
+		return model.IdempotencyKey{}, err
+	}
+
+	result := key
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var claimed string
+		serr := tx.QueryRow(ctx, claim, claimArgs...).Scan(&claimed)
+		if serr == nil {
+			return insert(ctx, tx, key.Uuid, info)
+		}
+		if !errors.Is(serr, pgx.ErrNoRows) {
+			return serr
+		}
+
+		// Действующий ключ: возвращаем результат первого запроса
+		var stored repoModel.IdempotencyKey
+		serr = tx.QueryRow(ctx, existing, existingArgs...).
+			Scan(&stored.Key, &stored.RequestHash, &stored.Uuid, &stored.ExpiresAt)
+		if serr != nil {
+			return serr
+		}
+		result = repoConverter.IdempotencyKeyToModel(stored)
+
+		return nil
+	})
+	if err != nil {
+		return model.IdempotencyKey{}, err
+	}
+
+	return result, nil
+}
+
+// insert сохраняет новое наблюдение с его первой ревизией и событием outbox в транзакции tx
+func insert(ctx context.Context, tx pgx.Tx, id string, info model.SightingInfo) error {
+	now := time.Now()
+
+	query, args, err := builder().
+		Insert(tableName).
+		Columns(insertColumns...).
+		Values(insertValues(id, info, now)...).
+		Suffix("RETURNING " + strings.Join(sightingColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	created, err := scanSighting(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		return err
+	}
+
+	return record(ctx, tx, repoConverter.NewRevision(ctx, model.RevisionActionCreated, nil, created, now))
+}
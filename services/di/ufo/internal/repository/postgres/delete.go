@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+)
+
+func (r *repository) Delete(ctx context.Context, uuid string, expectedVersion *int64) error {
+	if !isValidUUID(uuid) {
+		return model.ErrSightingNotFound
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return softDelete(ctx, tx, uuid, expectedVersion, time.Now())
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r.missReason(ctx, uuid)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// softDelete мягко удаляет наблюдение в транзакции tx - устанавливает deleted_at, если запись
+// еще не удалена и версия совпадает; pgx.ErrNoRows, если условие не выполнено
+func softDelete(ctx context.Context, tx pgx.Tx, uuid string, expectedVersion *int64, now time.Time) error {
+	query, args, err := builder().
+		Update(tableName).
+		Set("deleted_at", now).
+		Set("version", sq.Expr("version + 1")).
+		Where(mutableWhere(uuid, expectedVersion)).
+		Suffix("RETURNING " + strings.Join(sightingColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := scanSighting(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		return err
+	}
+
+	return record(ctx, tx, repoConverter.NewRevision(ctx, model.RevisionActionDeleted, nil, deleted, now))
+}
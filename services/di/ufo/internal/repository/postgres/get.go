@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+)
+
+func (r *repository) Get(ctx context.Context, uuid string) (model.Sighting, error) {
+	if !isValidUUID(uuid) {
+		return model.Sighting{}, model.ErrSightingNotFound
+	}
+
+	query, args, err := builder().
+		Select(sightingColumns...).
+		From(tableName).
+		Where(sq.Eq{"uuid": uuid}).
+		ToSql()
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	repoSighting, err := scanSighting(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Sighting{}, model.ErrSightingNotFound
+		}
+		return model.Sighting{}, err
+	}
+
+	if repoSighting.DeletedAt != nil {
+		return model.Sighting{}, model.ErrSightingDeleted
+	}
+
+	return repoConverter.SightingToModel(repoSighting), nil
+}
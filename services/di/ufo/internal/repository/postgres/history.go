@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+const revisionsTableName = "sighting_revisions"
+
+// revisionColumns порядок колонок совпадает с порядком полей в History и record
+var revisionColumns = []string{
+	"sighting_uuid",
+	"version",
+	"action",
+	"actor",
+	"occurred_at",
+	"changes",
+	"sighting",
+}
+
+func (r *repository) History(ctx context.Context, uuid string) ([]model.SightingRevision, error) {
+	if !isValidUUID(uuid) {
+		return nil, model.ErrSightingNotFound
+	}
+
+	query, args, err := builder().
+		Select(revisionColumns...).
+		From(revisionsTableName).
+		Where(sq.Eq{"sighting_uuid": uuid}).
+		OrderBy("version").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []repoModel.SightingRevision
+	for rows.Next() {
+		var revision repoModel.SightingRevision
+		err = rows.Scan(
+			&revision.SightingUuid,
+			&revision.Version,
+			&revision.Action,
+			&revision.Actor,
+			&revision.OccurredAt,
+			&revision.Changes,
+			&revision.Sighting,
+		)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	// Нет ревизий: наблюдения нет совсем или оно создано до появления истории
+	if len(revisions) == 0 {
+		err = r.exists(ctx, uuid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return repoConverter.RevisionsToModel(revisions), nil
+}
+
+// record сохраняет ревизии и события outbox о них в транзакции изменения наблюдения
+func record(ctx context.Context, tx pgx.Tx, revisions ...repoModel.SightingRevision) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	insert := builder().
+		Insert(revisionsTableName).
+		Columns(revisionColumns...)
+
+	events := make([]repoModel.OutboxEvent, 0, len(revisions))
+	for _, revision := range revisions {
+		insert = insert.Values(
+			revision.SightingUuid,
+			revision.Version,
+			revision.Action,
+			revision.Actor,
+			revision.OccurredAt,
+			revision.Changes,
+			revision.Sighting,
+		)
+
+		event, err := repoConverter.NewOutboxEvent(revision)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return recordEvents(ctx, tx, events)
+}
+
+// exists возвращает model.ErrSightingNotFound, если наблюдения нет, в том числе удаленного
+func (r *repository) exists(ctx context.Context, id string) error {
+	query, args, err := builder().
+		Select("1").
+		From(tableName).
+		Where(sq.Eq{"uuid": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var exists int
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrSightingNotFound
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// likeEscaper экранирует спецсимволы LIKE, чтобы подстрока искалась буквально
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *repository) List(ctx context.Context, query model.SightingListQuery) (model.SightingList, error) {
+	where, err := listWhere(query)
+	if err != nil {
+		return model.SightingList{}, err
+	}
+
+	// Запрашиваем на одну строку больше, чтобы понять, есть ли следующая страница
+	sql, args, err := builder().
+		Select(sightingColumns...).
+		From(tableName).
+		Where(where).
+		OrderBy("observed_at DESC NULLS LAST", "uuid DESC").
+		Limit(uint64(query.PageSize) + 1).
+		ToSql()
+	if err != nil {
+		return model.SightingList{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return model.SightingList{}, err
+	}
+	defer rows.Close()
+
+	repoSightings := make([]repoModel.Sighting, 0, query.PageSize+1)
+	for rows.Next() {
+		sighting, scanErr := scanSighting(rows)
+		if scanErr != nil {
+			return model.SightingList{}, scanErr
+		}
+		repoSightings = append(repoSightings, sighting)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return model.SightingList{}, err
+	}
+
+	var nextPageToken string
+	if len(repoSightings) > int(query.PageSize) {
+		repoSightings = repoSightings[:query.PageSize]
+
+		nextPageToken, err = repoConverter.CursorToPageToken(
+			repoConverter.SightingToCursor(repoSightings[len(repoSightings)-1]),
+		)
+		if err != nil {
+			return model.SightingList{}, err
+		}
+	}
+
+	return model.SightingList{
+		Sightings:     repoConverter.SightingsToModel(repoSightings),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// listWhere собирает условия выборки с учетом курсора предыдущей страницы
+func listWhere(query model.SightingListQuery) (sq.And, error) {
+	where := sq.And{}
+
+	if !query.Filter.IncludeDeleted {
+		where = append(where, sq.Eq{"deleted_at": nil})
+	}
+
+	if query.Filter.ObservedFrom != nil {
+		where = append(where, sq.GtOrEq{"observed_at": *query.Filter.ObservedFrom})
+	}
+
+	if query.Filter.ObservedTo != nil {
+		where = append(where, sq.Lt{"observed_at": *query.Filter.ObservedTo})
+	}
+
+	if query.Filter.Location != nil {
+		where = append(where, sq.ILike{"location": "%" + likeEscaper.Replace(*query.Filter.Location) + "%"})
+	}
+
+	if query.Filter.Color != nil {
+		where = append(where, sq.Eq{"color": *query.Filter.Color})
+	}
+
+	if query.Filter.Sound != nil {
+		where = append(where, sq.Eq{"sound": *query.Filter.Sound})
+	}
+
+	if query.PageToken != "" {
+		cursor, err := repoConverter.PageTokenToCursor(query.PageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isValidUUID(cursor.Uuid) {
+			return nil, model.ErrInvalidPageToken
+		}
+
+		where = append(where, afterCursor(cursor))
+	}
+
+	return where, nil
+}
+
+// afterCursor возвращает условие "строго после курсора" для сортировки
+// (observed_at DESC NULLS LAST, uuid DESC)
+func afterCursor(cursor repoModel.ListCursor) sq.Sqlizer {
+	if cursor.ObservedAt == nil {
+		return sq.And{
+			sq.Eq{"observed_at": nil},
+			sq.Lt{"uuid": cursor.Uuid},
+		}
+	}
+
+	return sq.Or{
+		sq.Lt{"observed_at": *cursor.ObservedAt},
+		sq.And{
+			sq.Eq{"observed_at": *cursor.ObservedAt},
+			sq.Lt{"uuid": cursor.Uuid},
+		},
+		sq.Eq{"observed_at": nil},
+	}
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Merge(
+	ctx context.Context,
+	targetUuid string,
+	updateInfo model.SightingUpdateInfo,
+	expectedVersion *int64,
+	duplicateUuids []string,
+) (model.Sighting, error) {
+	for _, uuid := range append([]string{targetUuid}, duplicateUuids...) {
+		if !isValidUUID(uuid) {
+			return model.Sighting{}, fmt.Errorf("sighting %s: %w", uuid, model.ErrSightingNotFound)
+		}
+	}
+
+	// failed наблюдение, на котором остановилась транзакция, - по нему выясняется причина ошибки
+	var (
+		merged repoModel.Sighting
+		failed string
+	)
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		now := time.Now()
+
+		failed = targetUuid
+		var uerr error
+		merged, uerr = update(ctx, tx, targetUuid, updateInfo, expectedVersion, now)
+		if uerr != nil {
+			return uerr
+		}
+
+		for _, uuid := range duplicateUuids {
+			failed = uuid
+			derr := softDelete(ctx, tx, uuid, nil, now)
+			if derr != nil {
+				return derr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Sighting{}, fmt.Errorf("sighting %s: %w", failed, r.missReason(ctx, failed))
+		}
+		return model.Sighting{}, err
+	}
+
+	return repoConverter.SightingToModel(merged), nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"math"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/geo"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// distanceExpr расстояние в метрах от coordinates до точки по формуле гаверсинусов,
+// аргументы: радиус Земли, широта, широта, долгота
+const distanceExpr = "2 * ? * asin(least(1, sqrt(" +
+	"power(sin(radians(coordinates[1] - ?) / 2), 2) + " +
+	"cos(radians(?)) * cos(radians(coordinates[1])) * power(sin(radians(coordinates[0] - ?) / 2), 2))))"
+
+func (r *repository) FindNearby(ctx context.Context, query model.NearbyQuery) ([]model.NearbySighting, error) {
+	center := query.Center
+	distanceArgs := []any{geo.EarthRadiusMeters, center.Latitude, center.Latitude, center.Longitude}
+
+	// Точки дальше радиуса по широте заведомо не подходят, это условие использует индекс
+	latitudeDelta := query.RadiusMeters / geo.EarthRadiusMeters * 180 / math.Pi
+
+	sql, args, err := builder().
+		Select(sightingColumns...).
+		Column(sq.Expr(distanceExpr+" AS distance_meters", distanceArgs...)).
+		From(tableName).
+		Where(sq.And{
+			sq.Eq{"deleted_at": nil},
+			sq.NotEq{"coordinates": nil},
+			sq.Expr("coordinates[1] BETWEEN ? AND ?", center.Latitude-latitudeDelta, center.Latitude+latitudeDelta),
+			sq.Expr(distanceExpr+" <= ?", append(distanceArgs, query.RadiusMeters)...),
+		}).
+		OrderBy("distance_meters", "uuid").
+		Limit(uint64(query.Limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoSightings := make([]repoModel.NearbySighting, 0, query.Limit)
+	for rows.Next() {
+		var sighting repoModel.NearbySighting
+		scanErr := rows.Scan(append(sightingFields(&sighting.Sighting), &sighting.DistanceMeters)...)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		repoSightings = append(repoSightings, sighting)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.NearbySightingsToModel(repoSightings), nil
+}
+
+// coordinatesScanner читает колонку coordinates (x - долгота, y - широта) в точку GeoJSON
+type coordinatesScanner struct {
+	dst **repoModel.GeoJSONPoint
+}
+
+func (s coordinatesScanner) ScanPoint(v pgtype.Point) error {
+	if !v.Valid {
+		*s.dst = nil
+		return nil
+	}
+
+	*s.dst = repoConverter.GeoPointToRepoModel(&model.GeoPoint{
+		Latitude:  v.P.Y,
+		Longitude: v.P.X,
+	})
+
+	return nil
+}
+
+// coordinatesValue значение колонки coordinates, NULL для отсутствующих координат
+func coordinatesValue(point *model.GeoPoint) pgtype.Point {
+	if point == nil {
+		return pgtype.Point{}
+	}
+
+	return pgtype.Point{
+		P:     pgtype.Vec2{X: point.Longitude, Y: point.Latitude},
+		Valid: true,
+	}
+}
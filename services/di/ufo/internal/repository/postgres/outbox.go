@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+const outboxTableName = "outbox"
+
+// outboxColumns порядок колонок совпадает с порядком полей в PendingEvents и recordEvents
+var outboxColumns = []string{
+	"id",
+	"type",
+	"sighting_uuid",
+	"version",
+	"payload",
+	"occurred_at",
+	"attempts",
+	"next_attempt_at",
+	"last_error",
+}
+
+func (r *repository) PendingEvents(ctx context.Context, now time.Time, limit int) ([]model.OutboxEvent, error) {
+	query, args, err := builder().
+		Select(outboxColumns...).
+		From(outboxTableName).
+		Where(sq.LtOrEq{"next_attempt_at": now}).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []repoModel.OutboxEvent
+	for rows.Next() {
+		var event repoModel.OutboxEvent
+		err = rows.Scan(
+			&event.Id,
+			&event.Type,
+			&event.SightingUuid,
+			&event.Version,
+			&event.Payload,
+			&event.OccurredAt,
+			&event.Attempts,
+			&event.NextAttemptAt,
+			&event.LastError,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.OutboxEventsToModel(events), nil
+}
+
+func (r *repository) AckEvent(ctx context.Context, id string) error {
+	if !isValidUUID(id) {
+		return nil
+	}
+
+	query, args, err := builder().
+		Delete(outboxTableName).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *repository) RetryEvent(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	if !isValidUUID(id) {
+		return nil
+	}
+
+	query, args, err := builder().
+		Update(outboxTableName).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("next_attempt_at", nextAttemptAt).
+		Set("last_error", lastError).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+// recordEvents сохраняет события outbox в транзакции изменения наблюдения
+func recordEvents(ctx context.Context, tx pgx.Tx, events []repoModel.OutboxEvent) error {
+	insert := builder().
+		Insert(outboxTableName).
+		Columns(outboxColumns...)
+
+	for _, event := range events {
+		insert = insert.Values(
+			event.Id,
+			event.Type,
+			event.SightingUuid,
+			event.Version,
+			event.Payload,
+			event.OccurredAt,
+			event.Attempts,
+			event.NextAttemptAt,
+			event.LastError,
+		)
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
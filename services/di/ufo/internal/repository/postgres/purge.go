@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Purge(ctx context.Context, deletedBefore time.Time) (model.PurgeResult, error) {
+	// RETURNING отдает вложения ровно тех строк, что удалены
+	query, args, err := builder().
+		Delete(tableName).
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		Suffix("RETURNING attachments").
+		ToSql()
+	if err != nil {
+		return model.PurgeResult{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return model.PurgeResult{}, err
+	}
+
+	var (
+		result      model.PurgeResult
+		attachments []repoModel.Attachment
+	)
+	_, err = pgx.ForEachRow(rows, []any{&attachments}, func() error {
+		result.PurgedCount++
+		for _, attachment := range attachments {
+			result.AttachmentIds = append(result.AttachmentIds, attachment.Id)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.PurgeResult{}, err
+	}
+
+	// Заодно удаляем истекшие ключи идемпотентности, чтобы таблица не росла
+	query, args, err = builder().
+		Delete(idempotencyKeysTableName).
+		Where(sq.LtOrEq{"expires_at": time.Now()}).
+		ToSql()
+	if err != nil {
+		return model.PurgeResult{}, err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return model.PurgeResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+var (
+	_ def.UFORepository    = (*repository)(nil)
+	_ def.OutboxRepository = (*repository)(nil)
+)
+
+const tableName = "sightings"
+
+// sightingColumns порядок колонок совпадает с порядком полей в scanSighting
+var sightingColumns = []string{
+	"uuid",
+	"observed_at",
+	"location",
+	"description",
+	"color",
+	"sound",
+	"duration_seconds",
+	"coordinates",
+	"created_at",
+	"updated_at",
+	"deleted_at",
+	"version",
+	"attachments",
+}
+
+// insertColumns колонки, заполняемые при создании наблюдения;
+// порядок совпадает с порядком значений в insertValues
+var insertColumns = []string{
+	"uuid",
+	"observed_at",
+	"location",
+	"description",
+	"color",
+	"sound",
+	"duration_seconds",
+	"coordinates",
+	"created_at",
+	"version",
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+	}
+}
+
+// builder возвращает squirrel-конструктор с плейсхолдерами PostgreSQL ($1, $2, ...)
+func builder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+}
+
+func insertValues(id string, info model.SightingInfo, createdAt time.Time) []any {
+	return []any{
+		id,
+		info.ObservedAt,
+		info.Location,
+		info.Description,
+		info.Color,
+		info.Sound,
+		info.DurationSeconds,
+		coordinatesValue(info.Coordinates),
+		createdAt,
+		1,
+	}
+}
+
+func scanSighting(row pgx.Row) (repoModel.Sighting, error) {
+	var sighting repoModel.Sighting
+
+	err := row.Scan(sightingFields(&sighting)...)
+	if err != nil {
+		return repoModel.Sighting{}, err
+	}
+
+	return sighting, nil
+}
+
+// sightingFields возвращает указатели на поля наблюдения в порядке sightingColumns
+func sightingFields(sighting *repoModel.Sighting) []any {
+	return []any{
+		&sighting.Uuid,
+		&sighting.Info.ObservedAt,
+		&sighting.Info.Location,
+		&sighting.Info.Description,
+		&sighting.Info.Color,
+		&sighting.Info.Sound,
+		&sighting.Info.DurationSeconds,
+		coordinatesScanner{dst: &sighting.Info.Geo},
+		&sighting.CreatedAt,
+		&sighting.UpdatedAt,
+		&sighting.DeletedAt,
+		&sighting.Version,
+		&sighting.Attachments,
+	}
+}
+
+// isValidUUID отсекает идентификаторы, которые PostgreSQL не сможет привести к типу uuid:
+// такие наблюдения заведомо не существуют
+func isValidUUID(id string) bool {
+	return uuid.Validate(id) == nil
+}
+
+// missReason объясняет, почему условная операция над наблюдением не затронула строк:
+// его нет совсем, оно мягко удалено или было изменено параллельно (версия не совпала)
+func (r *repository) missReason(ctx context.Context, id string) error {
+	query, args, err := builder().
+		Select("deleted_at IS NOT NULL").
+		From(tableName).
+		Where(sq.Eq{"uuid": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var deleted bool
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&deleted)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrSightingNotFound
+		}
+		return err
+	}
+
+	if deleted {
+		return model.ErrSightingDeleted
+	}
+
+	return model.ErrVersionConflict
+}
+
+// mutableWhere выбирает неудаленное наблюдение и, если задана ожидаемая версия,
+// только в этой версии
+func mutableWhere(id string, expectedVersion *int64) sq.And {
+	where := sq.And{
+		sq.Eq{"uuid": id},
+		sq.Eq{"deleted_at": nil},
+	}
+
+	if expectedVersion != nil {
+		where = append(where, sq.Eq{"version": *expectedVersion})
+	}
+
+	return where
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+)
+
+func (r *repository) Restore(ctx context.Context, uuid string) error {
+	if !isValidUUID(uuid) {
+		return model.ErrSightingNotFound
+	}
+
+	now := time.Now()
+
+	query, args, err := builder().
+		Update(tableName).
+		Set("deleted_at", nil).
+		Set("updated_at", now).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"uuid": uuid}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		Suffix("RETURNING " + strings.Join(sightingColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		restored, serr := scanSighting(tx.QueryRow(ctx, query, args...))
+		if serr != nil {
+			return serr
+		}
+
+		return record(ctx, tx, repoConverter.NewRevision(ctx, model.RevisionActionRestored, nil, restored, now))
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r.notRestoredReason(ctx, uuid)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// notRestoredReason объясняет, почему Restore ничего не восстановил:
+// либо записи нет, либо она не была удалена
+func (r *repository) notRestoredReason(ctx context.Context, uuid string) error {
+	err := r.exists(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return model.ErrSightingNotDeleted
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Search(ctx context.Context, query model.SightingSearchQuery) ([]model.SightingSearchHit, error) {
+	// Слова состоят только из букв и цифр, поэтому их можно соединить в tsquery напрямую;
+	// наблюдение подходит, если содержит любое из слов
+	tsQuery := strings.Join(query.Terms, " | ")
+
+	sql, args, err := builder().
+		Select(sightingColumns...).
+		Column(sq.Expr("ts_rank(search_vector, to_tsquery('simple', ?)) AS score", tsQuery)).
+		From(tableName).
+		Where(sq.And{
+			sq.Eq{"deleted_at": nil},
+			sq.Expr("search_vector @@ to_tsquery('simple', ?)", tsQuery),
+		}).
+		OrderBy("score DESC", "uuid").
+		Limit(uint64(query.Limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoHits := make([]repoModel.SightingSearchHit, 0, query.Limit)
+	for rows.Next() {
+		var hit repoModel.SightingSearchHit
+		scanErr := rows.Scan(append(sightingFields(&hit.Sighting), &hit.Score)...)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		repoHits = append(repoHits, hit)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.SearchHitsToModel(repoHits), nil
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// statsUnits единицы date_trunc для группировки по времени; неделя в PostgreSQL начинается с понедельника
+var statsUnits = map[model.StatsGroupBy]string{
+	model.StatsGroupByDay:   "day",
+	model.StatsGroupByWeek:  "week",
+	model.StatsGroupByMonth: "month",
+}
+
+func (r *repository) Stats(ctx context.Context, query model.StatsQuery) ([]model.StatsBucket, error) {
+	conditions := sq.And{sq.Eq{"deleted_at": nil}}
+	if query.GroupBy.IsTime() {
+		// Наблюдения без времени не попадают ни в один интервал
+		conditions = append(conditions, sq.NotEq{"observed_at": nil})
+	}
+	if query.ObservedFrom != nil {
+		conditions = append(conditions, sq.GtOrEq{"observed_at": *query.ObservedFrom})
+	}
+	if query.ObservedTo != nil {
+		conditions = append(conditions, sq.Lt{"observed_at": *query.ObservedTo})
+	}
+
+	var groupKey sq.Sqlizer
+	switch query.GroupBy {
+	case model.StatsGroupByColor:
+		groupKey = sq.Expr("color")
+	case model.StatsGroupBySound:
+		groupKey = sq.Expr("sound")
+	default:
+		groupKey = sq.Expr("date_trunc(?, observed_at, 'UTC')", statsUnits[query.GroupBy])
+	}
+
+	sql, args, err := builder().
+		Select().
+		Column(sq.Alias(groupKey, "group_key")).
+		Column("count(*)").
+		From(tableName).
+		Where(conditions).
+		GroupBy("group_key").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []repoModel.StatsBucket
+	for rows.Next() {
+		var bucket repoModel.StatsBucket
+
+		var key any
+		switch query.GroupBy {
+		case model.StatsGroupByColor:
+			key = &bucket.Color
+		case model.StatsGroupBySound:
+			key = &bucket.Sound
+		default:
+			key = &bucket.Start
+		}
+
+		scanErr := rows.Scan(key, &bucket.Count)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.StatsBucketsToModel(buckets, query.GroupBy), nil
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo, expectedVersion *int64) (model.Sighting, error) {
+	if !isValidUUID(uuid) {
+		return model.Sighting{}, model.ErrSightingNotFound
+	}
+
+	var updated repoModel.Sighting
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var uerr error
+		updated, uerr = update(ctx, tx, uuid, updateInfo, expectedVersion, time.Now())
+		return uerr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Sighting{}, r.missReason(ctx, uuid)
+		}
+		return model.Sighting{}, err
+	}
+
+	return repoConverter.SightingToModel(updated), nil
+}
+
+// update изменяет наблюдение в транзакции tx; pgx.ErrNoRows, если наблюдения нет,
+// оно удалено или версия не совпала
+func update(
+	ctx context.Context,
+	tx pgx.Tx,
+	uuid string,
+	updateInfo model.SightingUpdateInfo,
+	expectedVersion *int64,
+	now time.Time,
+) (repoModel.Sighting, error) {
+	builderUpdate := builder().
+		Update(tableName).
+		Set("updated_at", now).
+		Set("version", sq.Expr("version + 1"))
+
+	// Обновляем поля, только если они были установлены в запросе
+	if updateInfo.ObservedAt != nil {
+		builderUpdate = builderUpdate.Set("observed_at", *updateInfo.ObservedAt)
+	}
+
+	if updateInfo.Location != nil {
+		builderUpdate = builderUpdate.Set("location", *updateInfo.Location)
+	}
+
+	if updateInfo.Description != nil {
+		builderUpdate = builderUpdate.Set("description", *updateInfo.Description)
+	}
+
+	if updateInfo.Color != nil {
+		builderUpdate = builderUpdate.Set("color", *updateInfo.Color)
+	}
+
+	if updateInfo.Sound != nil {
+		builderUpdate = builderUpdate.Set("sound", *updateInfo.Sound)
+	}
+
+	if updateInfo.DurationSeconds != nil {
+		builderUpdate = builderUpdate.Set("duration_seconds", *updateInfo.DurationSeconds)
+	}
+
+	if updateInfo.Coordinates != nil {
+		builderUpdate = builderUpdate.Set("coordinates", coordinatesValue(updateInfo.Coordinates))
+	}
+
+	// Имена очищаемых полей совпадают с именами колонок
+	for _, field := range updateInfo.Clear {
+		builderUpdate = builderUpdate.Set(string(field), nil)
+	}
+
+	updateQuery, updateArgs, err := builderUpdate.
+		Where(sq.Eq{"uuid": uuid}).
+		Suffix("RETURNING " + strings.Join(sightingColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return repoModel.Sighting{}, err
+	}
+
+	// Для ревизии нужно состояние до изменения: блокируем строку, проверяя существование и версию,
+	// и до конца транзакции ее никто не изменит
+	selectQuery, selectArgs, err := builder().
+		Select(sightingColumns...).
+		From(tableName).
+		Where(mutableWhere(uuid, expectedVersion)).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return repoModel.Sighting{}, err
+	}
+
+	before, err := scanSighting(tx.QueryRow(ctx, selectQuery, selectArgs...))
+	if err != nil {
+		return repoModel.Sighting{}, err
+	}
+
+	updated, err := scanSighting(tx.QueryRow(ctx, updateQuery, updateArgs...))
+	if err != nil {
+		return repoModel.Sighting{}, err
+	}
+
+	err = record(ctx, tx, repoConverter.NewRevision(ctx, model.RevisionActionUpdated, &before.Info, updated, now))
+	if err != nil {
+		return repoModel.Sighting{}, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,13 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// Watch не поддерживается: в PostgreSQL нет аналога change streams с resume token,
+// а LISTEN/NOTIFY теряет события, пока подписчик отключен
+func (r *repository) Watch(_ context.Context, _ string) (model.SightingEventStream, error) {
+	return nil, model.ErrWatchNotSupported
+}
@@ -2,13 +2,54 @@ package repository
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 )
 
 type UFORepository interface {
 	Create(ctx context.Context, info model.SightingInfo) (string, error)
+	// CreateIdempotent создает наблюдение и сохраняет ключ идемпотентности атомарно. Если действующий
+	// ключ уже сохранен, наблюдение не создается и возвращается сохраненная запись; сравнить
+	// RequestHash должен вызывающий. Истекший ключ заменяется новым.
+	CreateIdempotent(ctx context.Context, info model.SightingInfo, key model.IdempotencyKey) (model.IdempotencyKey, error)
 	Get(ctx context.Context, uuid string) (model.Sighting, error)
-	Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo) error
-	Delete(ctx context.Context, uuid string) error
+	Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo, expectedVersion *int64) (model.Sighting, error)
+	Delete(ctx context.Context, uuid string, expectedVersion *int64) error
+	List(ctx context.Context, query model.SightingListQuery) (model.SightingList, error)
+	Restore(ctx context.Context, uuid string) error
+	Purge(ctx context.Context, deletedBefore time.Time) (model.PurgeResult, error)
+	BatchCreate(ctx context.Context, infos []model.SightingInfo) ([]model.SightingCreateResult, error)
+	BatchGet(ctx context.Context, uuids []string) ([]model.Sighting, error)
+	Watch(ctx context.Context, resumeToken string) (model.SightingEventStream, error)
+	Search(ctx context.Context, query model.SightingSearchQuery) ([]model.SightingSearchHit, error)
+	FindNearby(ctx context.Context, query model.NearbyQuery) ([]model.NearbySighting, error)
+	Stats(ctx context.Context, query model.StatsQuery) ([]model.StatsBucket, error)
+	AddAttachment(ctx context.Context, uuid string, attachment model.Attachment) (model.Sighting, error)
+	// Merge изменяет основное наблюдение в ожидаемой версии и мягко удаляет дубликаты одной операцией.
+	// Ошибка по любому из наблюдений содержит его UUID, и тогда ни одно наблюдение не меняется
+	Merge(ctx context.Context, targetUuid string, updateInfo model.SightingUpdateInfo, expectedVersion *int64, duplicateUuids []string) (model.Sighting, error)
+	// History возвращает ревизии наблюдения по возрастанию версии; ревизии переживают Purge
+	History(ctx context.Context, uuid string) ([]model.SightingRevision, error)
+}
+
+// OutboxRepository отдает события, которые UFORepository записывает в outbox
+// вместе с каждым изменением наблюдения, и отмечает результат их публикации
+type OutboxRepository interface {
+	// PendingEvents возвращает до limit событий, время попытки которых наступило к now, в порядке записи
+	PendingEvents(ctx context.Context, now time.Time, limit int) ([]model.OutboxEvent, error)
+	// AckEvent удаляет опубликованное событие; повторное подтверждение не ошибка
+	AckEvent(ctx context.Context, id string) error
+	// RetryEvent увеличивает счетчик попыток и откладывает событие до nextAttemptAt
+	RetryEvent(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error
+}
+
+// AttachmentStorage хранит содержимое вложений; метаданные вложений хранятся в наблюдении
+type AttachmentStorage interface {
+	// Save сохраняет содержимое целиком; при ошибке чтения content ничего не остается в хранилище
+	Save(ctx context.Context, id string, content io.Reader) error
+	// Open открывает содержимое для чтения, model.ErrAttachmentNotFound, если его нет
+	Open(ctx context.Context, id string) (io.ReadCloser, error)
+	Delete(ctx context.Context, id string) error
 }
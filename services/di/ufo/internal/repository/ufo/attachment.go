@@ -0,0 +1,45 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) AddAttachment(ctx context.Context, uuid string, attachment model.Attachment) (model.Sighting, error) {
+	now := time.Now()
+	updateDoc := bson.M{
+		"$push": bson.M{"attachments": repoConverter.AttachmentToRepoModel(attachment)},
+		"$set":  bson.M{"updated_at": now},
+		"$inc":  bson.M{"version": 1},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated repoModel.Sighting
+	err := r.inTransaction(ctx, func(ctx context.Context) error {
+		ferr := r.collection.FindOneAndUpdate(ctx, mutableFilter(uuid, nil), updateDoc, opts).Decode(&updated)
+		if ferr != nil {
+			return ferr
+		}
+
+		// Новая версия попадает в историю и outbox, как при Update; поля наблюдения не меняются
+		return r.record(ctx, repoConverter.NewRevision(ctx, model.RevisionActionUpdated, &updated.Info, updated, now))
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Sighting{}, r.missReason(ctx, uuid)
+		}
+		return model.Sighting{}, err
+	}
+
+	return repoConverter.SightingToModel(updated), nil
+}
@@ -0,0 +1,96 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) BatchCreate(ctx context.Context, infos []model.SightingInfo) ([]model.SightingCreateResult, error) {
+	now := time.Now()
+
+	sightings := make([]repoModel.Sighting, 0, len(infos))
+	for _, info := range infos {
+		sightings = append(sightings, repoModel.Sighting{
+			Uuid:      uuid.NewString(),
+			Info:      repoConverter.SightingInfoToRepoModel(info),
+			CreatedAt: now,
+			Version:   1,
+		})
+	}
+
+	results := make([]model.SightingCreateResult, len(sightings))
+
+	err := r.inTransaction(ctx, func(ctx context.Context) error {
+		// Результаты заполняются заново при каждом повторе транзакции
+		for i, sighting := range sightings {
+			results[i] = model.SightingCreateResult{Uuid: sighting.Uuid}
+		}
+
+		// Неупорядоченная вставка: ошибка одного документа не мешает вставить остальные.
+		// В транзакции любая ошибка записи отменяет ее целиком, поэтому частичный
+		// результат возможен только без транзакций.
+		_, err := r.collection.InsertMany(ctx, sightings, options.InsertMany().SetOrdered(false))
+		if err != nil {
+			var bulkErr mongo.BulkWriteException
+			if r.transactions || !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil || len(bulkErr.WriteErrors) == 0 {
+				return err
+			}
+
+			for _, writeErr := range bulkErr.WriteErrors {
+				results[writeErr.Index] = model.SightingCreateResult{Err: writeErr}
+			}
+		}
+
+		revisions := make([]repoModel.SightingRevision, 0, len(sightings))
+		for i, sighting := range sightings {
+			if results[i].Err == nil {
+				revisions = append(revisions, repoConverter.NewRevision(ctx, model.RevisionActionCreated, nil, sighting, now))
+			}
+		}
+
+		return r.record(ctx, revisions...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *repository) BatchGet(ctx context.Context, uuids []string) ([]model.Sighting, error) {
+	filter := bson.M{
+		"_id":        bson.M{"$in": uuids},
+		"deleted_at": nil,
+	}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		cerr := cursor.Close(ctx)
+		if cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoSightings []repoModel.Sighting
+	err = cursor.All(ctx, &repoSightings)
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.SightingsToModel(repoSightings), nil
+}
@@ -0,0 +1,50 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// mutableFilter выбирает неудаленное наблюдение и, если задана ожидаемая версия,
+// только в этой версии
+func mutableFilter(uuid string, expectedVersion *int64) bson.M {
+	filter := bson.M{"_id": uuid, "deleted_at": nil}
+
+	if expectedVersion != nil {
+		// Документы, созданные до появления версий, не содержат поля version
+		if *expectedVersion == 0 {
+			filter["version"] = bson.M{"$in": bson.A{int64(0), nil}}
+		} else {
+			filter["version"] = *expectedVersion
+		}
+	}
+
+	return filter
+}
+
+// missReason объясняет, почему условная операция над наблюдением не нашла документ:
+// его нет совсем, он мягко удален или был изменен параллельно (версия не совпала)
+func (r *repository) missReason(ctx context.Context, uuid string) error {
+	var existing struct {
+		DeletedAt any `bson:"deleted_at"`
+	}
+
+	err := r.collection.FindOne(ctx, bson.M{"_id": uuid}).Decode(&existing)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.ErrSightingNotFound
+		}
+		return err
+	}
+
+	if existing.DeletedAt != nil {
+		return model.ErrSightingDeleted
+	}
+
+	return model.ErrVersionConflict
+}
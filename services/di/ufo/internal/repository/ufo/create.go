@@ -2,9 +2,12 @@ package ufo
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
@@ -12,18 +15,146 @@ import (
 )
 
 func (r *repository) Create(ctx context.Context, info model.SightingInfo) (string, error) {
-	newUUID := uuid.NewString()
+	sighting, now := newSighting(info)
 
-	sighting := repoModel.Sighting{
-		Uuid:      newUUID,
-		Info:      repoConverter.SightingInfoToRepoModel(info),
-		CreatedAt: time.Now(),
+	err := r.inTransaction(ctx, func(ctx context.Context) error {
+		return r.insert(ctx, sighting, now)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return sighting.Uuid, nil
+}
+
+// Без транзакций параллельный запрос с тем же ключом ждет, пока первый сохранит наблюдение
+const (
+	// pendingKeyTimeout после него незавершенный ключ считается брошенным упавшим процессом
+	pendingKeyTimeout = 5 * time.Second
+	pendingKeyPoll    = 20 * time.Millisecond
+)
+
+func (r *repository) CreateIdempotent(ctx context.Context, info model.SightingInfo, key model.IdempotencyKey) (model.IdempotencyKey, error) {
+	sighting, now := newSighting(info)
+	key.Uuid = sighting.Uuid
+
+	// Ключ вставляется первым: уникальный _id не дает параллельному запросу с тем же ключом
+	// создать второе наблюдение даже без транзакций. Каждый повтор цикла означает, что
+	// чужой ключ истек или освобожден, либо что его владелец еще сохраняет наблюдение.
+	var waitUntil time.Time
+	for {
+		err := r.claimAndInsert(ctx, key, sighting, now)
+		if err == nil {
+			return key, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return model.IdempotencyKey{}, err
+		}
+
+		var existing repoModel.IdempotencyKey
+		err = r.idempotencyKeys.FindOne(ctx, bson.M{"_id": key.Key}).Decode(&existing)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue
+			}
+			return model.IdempotencyKey{}, err
+		}
+
+		if !existing.ExpiresAt.After(time.Now()) {
+			// TTL-индекс удаляет истекшие ключи с задержкой, поэтому истекший ключ удаляем сами
+			_, err = r.idempotencyKeys.DeleteOne(ctx, bson.M{"_id": key.Key, "expires_at": existing.ExpiresAt})
+			if err != nil {
+				return model.IdempotencyKey{}, err
+			}
+			continue
+		}
+
+		if !existing.Pending {
+			return repoConverter.IdempotencyKeyToModel(existing), nil
+		}
+
+		// Наблюдение уже сохранено, не подтвердилась только запись ключа
+		stored, err := r.collection.CountDocuments(ctx, bson.M{"_id": existing.Uuid})
+		if err != nil {
+			return model.IdempotencyKey{}, err
+		}
+		if stored > 0 {
+			return repoConverter.IdempotencyKeyToModel(existing), nil
+		}
+
+		if waitUntil.IsZero() {
+			waitUntil = time.Now().Add(pendingKeyTimeout)
+		}
+		if time.Now().After(waitUntil) {
+			// Владелец ключа не сохранил наблюдение и не освободил ключ: процесс упал между вставками
+			_, err = r.idempotencyKeys.DeleteOne(ctx, bson.M{"_id": key.Key, "uuid": existing.Uuid, "pending": true})
+			if err != nil {
+				return model.IdempotencyKey{}, err
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return model.IdempotencyKey{}, ctx.Err()
+		case <-time.After(pendingKeyPoll):
+		}
+	}
+}
+
+// claimAndInsert занимает ключ и сохраняет наблюдение. В транзакции ключ без наблюдения
+// не фиксируется. Без транзакции ключ занимается в состоянии pending: при ошибке вставки
+// наблюдения он удаляется, иначе повторы получали бы UUID несохраненного наблюдения
+func (r *repository) claimAndInsert(ctx context.Context, key model.IdempotencyKey, sighting repoModel.Sighting, now time.Time) error {
+	if r.transactions {
+		return r.inTransaction(ctx, func(ctx context.Context) error {
+			_, err := r.idempotencyKeys.InsertOne(ctx, repoConverter.IdempotencyKeyToRepoModel(key))
+			if err != nil {
+				return err
+			}
+
+			return r.insert(ctx, sighting, now)
+		})
+	}
+
+	pending := repoConverter.IdempotencyKeyToRepoModel(key)
+	pending.Pending = true
+	_, err := r.idempotencyKeys.InsertOne(ctx, pending)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.InsertOne(ctx, sighting)
+	if err != nil {
+		// Ключ освобождается и при отмене запроса
+		_, derr := r.idempotencyKeys.DeleteOne(context.WithoutCancel(ctx), bson.M{"_id": key.Key, "uuid": key.Uuid})
+		return errors.Join(err, derr)
 	}
 
+	// Наблюдение сохранено: ключ подтверждается, даже если ревизия не запишется
+	_, err = r.idempotencyKeys.UpdateOne(ctx, bson.M{"_id": key.Key, "uuid": key.Uuid}, bson.M{"$unset": bson.M{"pending": ""}})
+	rerr := r.record(ctx, repoConverter.NewRevision(ctx, model.RevisionActionCreated, nil, sighting, now))
+
+	return errors.Join(err, rerr)
+}
+
+func newSighting(info model.SightingInfo) (repoModel.Sighting, time.Time) {
+	now := time.Now()
+
+	return repoModel.Sighting{
+		Uuid:      uuid.NewString(),
+		Info:      repoConverter.SightingInfoToRepoModel(info),
+		CreatedAt: now,
+		Version:   1,
+	}, now
+}
+
+// insert сохраняет новое наблюдение с его первой ревизией. Вызывается внутри inTransaction.
+func (r *repository) insert(ctx context.Context, sighting repoModel.Sighting, now time.Time) error {
 	_, err := r.collection.InsertOne(ctx, sighting)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	return newUUID, nil
+	return r.record(ctx, repoConverter.NewRevision(ctx, model.RevisionActionCreated, nil, sighting, now))
 }
@@ -5,35 +5,40 @@ import (
 	"errors"
 	"time"
 
-	"github.com/samber/lo"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
 )
 
-func (r *repository) Delete(ctx context.Context, uuid string) error {
-	// Проверяем существование документа
-	var existing bson.M
-	err := r.collection.FindOne(ctx, bson.M{"_id": uuid}).Decode(&existing)
-	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return model.ErrSightingNotFound
-		}
-		return err
-	}
+func (r *repository) Delete(ctx context.Context, uuid string, expectedVersion *int64) error {
+	return r.inTransaction(ctx, func(ctx context.Context) error {
+		return r.delete(ctx, uuid, expectedVersion, time.Now())
+	})
+}
 
-	// Мягкое удаление - устанавливаем deleted_at
+// delete мягко удаляет наблюдение - устанавливает deleted_at, если документ еще не удален и версия совпадает
+func (r *repository) delete(ctx context.Context, uuid string, expectedVersion *int64, now time.Time) error {
 	updateDoc := bson.M{
 		"$set": bson.M{
-			"deleted_at": lo.ToPtr(time.Now()),
+			"deleted_at": now,
 		},
+		"$inc": bson.M{"version": 1},
 	}
 
-	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": uuid}, updateDoc)
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var deleted repoModel.Sighting
+	err := r.collection.FindOneAndUpdate(ctx, mutableFilter(uuid, expectedVersion), updateDoc, opts).Decode(&deleted)
 	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return r.missReason(ctx, uuid)
+		}
 		return err
 	}
 
-	return nil
+	return r.record(ctx, repoConverter.NewRevision(ctx, model.RevisionActionDeleted, nil, deleted, now))
 }
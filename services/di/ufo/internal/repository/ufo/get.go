@@ -23,5 +23,9 @@ func (r *repository) Get(ctx context.Context, uuid string) (model.Sighting, erro
 		return model.Sighting{}, err
 	}
 
+	if repoSighting.DeletedAt != nil {
+		return model.Sighting{}, model.ErrSightingDeleted
+	}
+
 	return repoConverter.SightingToModel(repoSighting), nil
 }
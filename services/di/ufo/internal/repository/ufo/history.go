@@ -0,0 +1,75 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) History(ctx context.Context, uuid string) ([]model.SightingRevision, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "version", Value: 1}})
+
+	cursor, err := r.revisions.Find(ctx, bson.M{"sighting_uuid": uuid}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		cerr := cursor.Close(ctx)
+		if cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var revisions []repoModel.SightingRevision
+	err = cursor.All(ctx, &revisions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Нет ревизий: наблюдения нет совсем или оно создано до появления истории
+	if len(revisions) == 0 {
+		err = r.collection.FindOne(ctx, bson.M{"_id": uuid}).Err()
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, model.ErrSightingNotFound
+			}
+			return nil, err
+		}
+	}
+
+	return repoConverter.RevisionsToModel(revisions), nil
+}
+
+// record сохраняет ревизии и события outbox о них. Вызывается внутри inTransaction
+// вместе с изменением наблюдения.
+func (r *repository) record(ctx context.Context, revisions ...repoModel.SightingRevision) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	events := make([]repoModel.OutboxEvent, 0, len(revisions))
+	for _, revision := range revisions {
+		event, err := repoConverter.NewOutboxEvent(revision)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+
+	_, err := r.revisions.InsertMany(ctx, revisions)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.outbox.InsertMany(ctx, events)
+	return err
+}
@@ -0,0 +1,134 @@
+package ufo
+
+import (
+	"context"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) List(ctx context.Context, query model.SightingListQuery) (model.SightingList, error) {
+	filter, err := listFilter(query)
+	if err != nil {
+		return model.SightingList{}, err
+	}
+
+	// Запрашиваем на один документ больше, чтобы понять, есть ли следующая страница
+	opts := options.Find().
+		SetSort(bson.D{
+			{Key: "info.observed_at", Value: -1},
+			{Key: "_id", Value: -1},
+		}).
+		SetLimit(int64(query.PageSize) + 1)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return model.SightingList{}, err
+	}
+	defer func() {
+		cerr := cursor.Close(ctx)
+		if cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoSightings []repoModel.Sighting
+	err = cursor.All(ctx, &repoSightings)
+	if err != nil {
+		return model.SightingList{}, err
+	}
+
+	var nextPageToken string
+	if len(repoSightings) > int(query.PageSize) {
+		repoSightings = repoSightings[:query.PageSize]
+
+		nextPageToken, err = repoConverter.CursorToPageToken(
+			repoConverter.SightingToCursor(repoSightings[len(repoSightings)-1]),
+		)
+		if err != nil {
+			return model.SightingList{}, err
+		}
+	}
+
+	return model.SightingList{
+		Sightings:     repoConverter.SightingsToModel(repoSightings),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// listFilter собирает фильтр выборки с учетом курсора предыдущей страницы
+func listFilter(query model.SightingListQuery) (bson.M, error) {
+	conditions := bson.A{}
+
+	if !query.Filter.IncludeDeleted {
+		conditions = append(conditions, bson.M{"deleted_at": nil})
+	}
+
+	observedAt := bson.M{}
+	if query.Filter.ObservedFrom != nil {
+		observedAt["$gte"] = *query.Filter.ObservedFrom
+	}
+	if query.Filter.ObservedTo != nil {
+		observedAt["$lt"] = *query.Filter.ObservedTo
+	}
+	if len(observedAt) > 0 {
+		conditions = append(conditions, bson.M{"info.observed_at": observedAt})
+	}
+
+	if query.Filter.Location != nil {
+		conditions = append(conditions, bson.M{"info.location": bson.Regex{
+			Pattern: regexp.QuoteMeta(*query.Filter.Location),
+			Options: "i",
+		}})
+	}
+
+	if query.Filter.Color != nil {
+		conditions = append(conditions, bson.M{"info.color": *query.Filter.Color})
+	}
+
+	if query.Filter.Sound != nil {
+		conditions = append(conditions, bson.M{"info.sound": *query.Filter.Sound})
+	}
+
+	if query.PageToken != "" {
+		cursor, err := repoConverter.PageTokenToCursor(query.PageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions, afterCursor(cursor))
+	}
+
+	if len(conditions) == 0 {
+		return bson.M{}, nil
+	}
+
+	return bson.M{"$and": conditions}, nil
+}
+
+// afterCursor возвращает условие "строго после курсора" для сортировки
+// (info.observed_at DESC, _id DESC). Наблюдения без observed_at идут в самом конце.
+func afterCursor(cursor repoModel.ListCursor) bson.M {
+	if cursor.ObservedAt == nil {
+		return bson.M{
+			"info.observed_at": nil,
+			"_id":              bson.M{"$lt": cursor.Uuid},
+		}
+	}
+
+	return bson.M{"$or": bson.A{
+		bson.M{"info.observed_at": bson.M{"$lt": *cursor.ObservedAt}},
+		bson.M{
+			"info.observed_at": *cursor.ObservedAt,
+			"_id":              bson.M{"$lt": cursor.Uuid},
+		},
+		bson.M{"info.observed_at": nil},
+	}}
+}
@@ -0,0 +1,84 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Merge(
+	ctx context.Context,
+	targetUuid string,
+	updateInfo model.SightingUpdateInfo,
+	expectedVersion *int64,
+	duplicateUuids []string,
+) (model.Sighting, error) {
+	// Без транзакций изменения не откатываются, поэтому дубликаты проверяются заранее:
+	// так частичное объединение возможно только при гонке с параллельным удалением
+	err := r.checkMutable(ctx, duplicateUuids)
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	for {
+		var merged repoModel.Sighting
+		err = r.inTransaction(ctx, func(ctx context.Context) error {
+			now := time.Now()
+
+			var uerr error
+			merged, uerr = r.update(ctx, targetUuid, updateInfo, expectedVersion, now)
+			if uerr != nil {
+				if errors.Is(uerr, errVersionMoved) {
+					return uerr
+				}
+				return fmt.Errorf("sighting %s: %w", targetUuid, uerr)
+			}
+
+			for _, uuid := range duplicateUuids {
+				derr := r.delete(ctx, uuid, nil, now)
+				if derr != nil {
+					return fmt.Errorf("sighting %s: %w", uuid, derr)
+				}
+			}
+
+			return nil
+		})
+		if errors.Is(err, errVersionMoved) {
+			continue
+		}
+		if err != nil {
+			return model.Sighting{}, err
+		}
+
+		return repoConverter.SightingToModel(merged), nil
+	}
+}
+
+// checkMutable проверяет, что все наблюдения существуют и не удалены
+func (r *repository) checkMutable(ctx context.Context, uuids []string) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": bson.M{"$in": uuids}, "deleted_at": nil})
+	if err != nil {
+		return err
+	}
+
+	if int(count) == len(uuids) {
+		return nil
+	}
+
+	// Для существующего неудаленного документа missReason без проверки версии возвращает ErrVersionConflict
+	for _, uuid := range uuids {
+		reason := r.missReason(ctx, uuid)
+		if !errors.Is(reason, model.ErrVersionConflict) {
+			return fmt.Errorf("sighting %s: %w", uuid, reason)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package ufo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) FindNearby(ctx context.Context, query model.NearbyQuery) ([]model.NearbySighting, error) {
+	// $geoNear сам сортирует по расстоянию и должен быть первой стадией конвейера;
+	// для точки GeoJSON расстояния и maxDistance измеряются в метрах
+	pipeline := bson.A{
+		bson.M{"$geoNear": bson.M{
+			"near":          repoConverter.GeoPointToRepoModel(&query.Center),
+			"key":           "info.geo",
+			"distanceField": "distance_meters",
+			"maxDistance":   query.RadiusMeters,
+			"query":         bson.M{"deleted_at": nil},
+			"spherical":     true,
+		}},
+		bson.M{"$limit": query.Limit},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		cerr := cursor.Close(ctx)
+		if cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoSightings []repoModel.NearbySighting
+	err = cursor.All(ctx, &repoSightings)
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.NearbySightingsToModel(repoSightings), nil
+}
@@ -0,0 +1,57 @@
+package ufo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) PendingEvents(ctx context.Context, now time.Time, limit int) ([]model.OutboxEvent, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.outbox.Find(ctx, bson.M{"next_attempt_at": bson.M{"$lte": now}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		cerr := cursor.Close(ctx)
+		if cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var events []repoModel.OutboxEvent
+	err = cursor.All(ctx, &events)
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.OutboxEventsToModel(events), nil
+}
+
+func (r *repository) AckEvent(ctx context.Context, id string) error {
+	_, err := r.outbox.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *repository) RetryEvent(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	_, err := r.outbox.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+		},
+		"$inc": bson.M{"attempts": 1},
+	})
+
+	return err
+}
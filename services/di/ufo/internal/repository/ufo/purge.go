@@ -0,0 +1,68 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Purge(ctx context.Context, deletedBefore time.Time) (model.PurgeResult, error) {
+	filter := bson.M{"deleted_at": bson.M{"$lt": deletedBefore}}
+
+	// Сначала запоминаем вложения кандидатов: после удаления документов их не узнать
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"attachments.id": 1}))
+	if err != nil {
+		return model.PurgeResult{}, err
+	}
+
+	var candidates []repoModel.Sighting
+	err = cursor.All(ctx, &candidates)
+	if err != nil {
+		return model.PurgeResult{}, err
+	}
+
+	if len(candidates) == 0 {
+		return model.PurgeResult{}, nil
+	}
+
+	uuids := make([]string, 0, len(candidates))
+	for _, sighting := range candidates {
+		uuids = append(uuids, sighting.Uuid)
+	}
+
+	res, err := r.collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": uuids}, "deleted_at": bson.M{"$lt": deletedBefore}})
+	if err != nil {
+		return model.PurgeResult{}, err
+	}
+
+	// Наблюдение могли восстановить между запросами: его вложения остаются
+	var survivors []string
+	err = r.collection.Distinct(ctx, "_id", bson.M{"_id": bson.M{"$in": uuids}}).Decode(&survivors)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return model.PurgeResult{}, err
+	}
+
+	restored := make(map[string]struct{}, len(survivors))
+	for _, uuid := range survivors {
+		restored[uuid] = struct{}{}
+	}
+
+	result := model.PurgeResult{PurgedCount: res.DeletedCount}
+	for _, sighting := range candidates {
+		if _, ok := restored[sighting.Uuid]; ok {
+			continue
+		}
+		for _, attachment := range sighting.Attachments {
+			result.AttachmentIds = append(result.AttachmentIds, attachment.Id)
+		}
+	}
+
+	return result, nil
+}
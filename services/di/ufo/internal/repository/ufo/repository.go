@@ -1,25 +1,141 @@
 package ufo
 
 import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
 	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
 )
 
-var _ def.UFORepository = (*repository)(nil)
+var (
+	_ def.UFORepository    = (*repository)(nil)
+	_ def.OutboxRepository = (*repository)(nil)
+)
 
 const (
-	collectionName = "sightings"
+	collectionName                = "sightings"
+	revisionsCollectionName       = "sighting_revisions"
+	outboxCollectionName          = "outbox"
+	idempotencyKeysCollectionName = "idempotency_keys"
+
+	indexTimeout = 10 * time.Second
+
+	// Совпадение в месте наблюдения весит больше, чем в описании
+	locationWeight    = 2
+	descriptionWeight = 1
 )
 
 type repository struct {
-	collection *mongo.Collection
+	collection      *mongo.Collection
+	revisions       *mongo.Collection
+	outbox          *mongo.Collection
+	idempotencyKeys *mongo.Collection
+
+	// transactions поддерживаются только на replica set и sharded cluster
+	transactions bool
 }
 
 func NewRepository(db *mongo.Database) *repository {
-	repo := &repository{
-		collection: db.Collection(collectionName),
+	collection := db.Collection(collectionName)
+
+	// Создаем индексы при инициализации
+	indexModels := []mongo.IndexModel{
+		{
+			// Индекс под сортировку и курсорную пагинацию в List
+			Keys: bson.D{
+				{Key: "info.observed_at", Value: -1},
+				{Key: "_id", Value: -1},
+			},
+		},
+		{
+			// Индекс под окончательное удаление в Purge
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			// Текстовый индекс под Search. Язык не задаем: наблюдения пишут на разных языках,
+			// а без стемминга найденные слова совпадают с подсветкой в сервисе
+			Keys: bson.D{
+				{Key: "info.location", Value: "text"},
+				{Key: "info.description", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("sightings_text").
+				SetDefaultLanguage("none").
+				SetWeights(bson.D{
+					{Key: "info.location", Value: locationWeight},
+					{Key: "info.description", Value: descriptionWeight},
+				}),
+		},
+		{
+			// Геоиндекс под FindNearby; наблюдения без координат в него не попадают
+			Keys: bson.D{{Key: "info.geo", Value: "2dsphere"}},
+		},
 	}
 
-	return repo
+	ctx, cancel := context.WithTimeout(context.Background(), indexTimeout)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		panic(err)
+	}
+
+	// Одна ревизия на версию наблюдения; индекс же отдает историю по порядку версий
+	revisions := db.Collection(revisionsCollectionName)
+	_, err = revisions.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "sighting_uuid", Value: 1},
+			{Key: "version", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// Индекс под выборку событий, готовых к публикации
+	outbox := db.Collection(outboxCollectionName)
+	_, err = outbox.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "next_attempt_at", Value: 1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// TTL-индекс: MongoDB сама удаляет истекшие ключи идемпотентности
+	idempotencyKeys := db.Collection(idempotencyKeysCollectionName)
+	_, err = idempotencyKeys.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	transactions, err := supportsTransactions(ctx, db)
+	if err != nil {
+		panic(err)
+	}
+	if !transactions {
+		logger.Warn(ctx, "⚠️ MongoDB is a standalone mongod without transactions: sighting changes, "+
+			"revisions and outbox events are written separately and events are lost on a crash between writes. "+
+			"Run MongoDB as a replica set (MONGO_REPLICA_SET) for at-least-once event delivery")
+		// Change streams тоже работают только на replica set и sharded cluster
+		logger.Warn(ctx, "⚠️ MongoDB change streams are unavailable on a standalone mongod: "+
+			"WatchSightings returns UNIMPLEMENTED")
+	}
+
+	return &repository{
+		collection:      collection,
+		revisions:       revisions,
+		outbox:          outbox,
+		idempotencyKeys: idempotencyKeys,
+		transactions:    transactions,
+	}
 }
@@ -0,0 +1,58 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Restore(ctx context.Context, uuid string) error {
+	now := time.Now()
+	updateDoc := bson.M{
+		"$set": bson.M{
+			"updated_at": now,
+		},
+		"$unset": bson.M{
+			"deleted_at": "",
+		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	return r.inTransaction(ctx, func(ctx context.Context) error {
+		var restored repoModel.Sighting
+		err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": uuid, "deleted_at": bson.M{"$ne": nil}}, updateDoc, opts).
+			Decode(&restored)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return r.notRestoredReason(ctx, uuid)
+			}
+			return err
+		}
+
+		return r.record(ctx, repoConverter.NewRevision(ctx, model.RevisionActionRestored, nil, restored, now))
+	})
+}
+
+// notRestoredReason объясняет, почему Restore ничего не восстановил:
+// либо документа нет, либо он не был удален
+func (r *repository) notRestoredReason(ctx context.Context, uuid string) error {
+	err := r.collection.FindOne(ctx, bson.M{"_id": uuid}).Err()
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.ErrSightingNotFound
+		}
+		return err
+	}
+
+	return model.ErrSightingNotDeleted
+}
@@ -0,0 +1,52 @@
+package ufo
+
+import (
+	"context"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+func (r *repository) Search(ctx context.Context, query model.SightingSearchQuery) ([]model.SightingSearchHit, error) {
+	// Слова через пробел MongoDB ищет по "или"; кавычки и минус в них не попадают,
+	// поэтому фраз и исключений в запросе не бывает
+	filter := bson.M{
+		"$text":      bson.M{"$search": strings.Join(query.Terms, " ")},
+		"deleted_at": nil,
+	}
+
+	textScore := bson.M{"$meta": "textScore"}
+	opts := options.Find().
+		SetProjection(bson.M{"score": textScore}).
+		SetSort(bson.D{
+			{Key: "score", Value: textScore},
+			{Key: "_id", Value: 1},
+		}).
+		SetLimit(int64(query.Limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		cerr := cursor.Close(ctx)
+		if cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoHits []repoModel.SightingSearchHit
+	err = cursor.All(ctx, &repoHits)
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.SearchHitsToModel(repoHits), nil
+}
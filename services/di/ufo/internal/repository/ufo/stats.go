@@ -0,0 +1,91 @@
+package ufo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// statsUnits единицы $dateTrunc для группировки по времени
+var statsUnits = map[model.StatsGroupBy]string{
+	model.StatsGroupByDay:   "day",
+	model.StatsGroupByWeek:  "week",
+	model.StatsGroupByMonth: "month",
+}
+
+func (r *repository) Stats(ctx context.Context, query model.StatsQuery) ([]model.StatsBucket, error) {
+	conditions := bson.A{bson.M{"deleted_at": nil}}
+
+	observedAt := bson.M{}
+	if query.GroupBy.IsTime() {
+		// Наблюдения без времени не попадают ни в один интервал
+		observedAt["$type"] = "date"
+	}
+	if query.ObservedFrom != nil {
+		observedAt["$gte"] = *query.ObservedFrom
+	}
+	if query.ObservedTo != nil {
+		observedAt["$lt"] = *query.ObservedTo
+	}
+	if len(observedAt) > 0 {
+		conditions = append(conditions, bson.M{"info.observed_at": observedAt})
+	}
+
+	var groupKey any
+	var field string
+	switch query.GroupBy {
+	case model.StatsGroupByColor:
+		groupKey, field = "$info.color", "color"
+	case model.StatsGroupBySound:
+		groupKey, field = "$info.sound", "sound"
+	default:
+		dateTrunc := bson.M{
+			"date":     "$info.observed_at",
+			"unit":     statsUnits[query.GroupBy],
+			"timezone": "UTC",
+		}
+		if query.GroupBy == model.StatsGroupByWeek {
+			dateTrunc["startOfWeek"] = "monday"
+		}
+		groupKey, field = bson.M{"$dateTrunc": dateTrunc}, "start"
+	}
+
+	// Отсутствующие поля цвета и звука группируются в общую группу с _id null
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"$and": conditions}},
+		bson.M{"$group": bson.M{
+			"_id":   groupKey,
+			"count": bson.M{"$sum": 1},
+		}},
+		bson.M{"$project": bson.M{
+			"_id":   0,
+			field:   "$_id",
+			"count": 1,
+		}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		cerr := cursor.Close(ctx)
+		if cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var buckets []repoModel.StatsBucket
+	err = cursor.All(ctx, &buckets)
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.StatsBucketsToModel(buckets, query.GroupBy), nil
+}
@@ -0,0 +1,49 @@
+package ufo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// mongosMessage - значение поля msg в ответе hello от mongos
+const mongosMessage = "isdbgrid"
+
+// supportsTransactions проверяет, что сервер - член replica set или mongos:
+// на одиночном mongod транзакции недоступны
+func supportsTransactions(ctx context.Context, db *mongo.Database) (bool, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+
+	err := db.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return false, err
+	}
+
+	return hello.SetName != "" || hello.Msg == mongosMessage, nil
+}
+
+// inTransaction выполняет fn в транзакции, чтобы изменение наблюдения, его ревизия и событие
+// outbox записались вместе. Драйвер повторяет fn при временных ошибках транзакции, поэтому
+// fn не должна иметь побочных эффектов вне базы. Без поддержки транзакций fn выполняется
+// как есть, и при сбое между запросами ревизия и событие могут потеряться.
+func (r *repository) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !r.transactions {
+		return fn(ctx)
+	}
+
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx context.Context) (any, error) {
+		return nil, fn(ctx)
+	})
+
+	return err
+}
@@ -7,57 +7,136 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
 )
 
-func (r *repository) Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo) error {
-	// Проверяем существование документа
-	var existing bson.M
-	err := r.collection.FindOne(ctx, bson.M{"_id": uuid}).Decode(&existing)
+func (r *repository) Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo, expectedVersion *int64) (model.Sighting, error) {
+	// Для ревизии нужно состояние до изменения: читаем документ и обновляем его, только если версия
+	// с тех пор не изменилась. Если клиент не передал версию, параллельное изменение не ошибка -
+	// перечитываем документ и пробуем снова. Каждый повтор означает, что чужое изменение
+	// успешно записано, поэтому цикл не бесконечен; отмена ctx прерывает его на FindOne.
+	for {
+		var updated repoModel.Sighting
+		err := r.inTransaction(ctx, func(ctx context.Context) error {
+			var uerr error
+			updated, uerr = r.update(ctx, uuid, updateInfo, expectedVersion, time.Now())
+			return uerr
+		})
+		if errors.Is(err, errVersionMoved) {
+			continue
+		}
+		if err != nil {
+			return model.Sighting{}, err
+		}
+
+		return repoConverter.SightingToModel(updated), nil
+	}
+}
+
+// update читает документ и изменяет его, если версия с момента чтения не изменилась;
+// errVersionMoved, если изменилась, а клиент версию не передавал
+func (r *repository) update(
+	ctx context.Context,
+	uuid string,
+	updateInfo model.SightingUpdateInfo,
+	expectedVersion *int64,
+	now time.Time,
+) (repoModel.Sighting, error) {
+	var before repoModel.Sighting
+	err := r.collection.FindOne(ctx, mutableFilter(uuid, expectedVersion)).Decode(&before)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return model.ErrSightingNotFound
+			return repoModel.Sighting{}, r.missReason(ctx, uuid)
 		}
-		return err
+		return repoModel.Sighting{}, err
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated repoModel.Sighting
+	err = r.collection.FindOneAndUpdate(ctx, mutableFilter(uuid, &before.Version), updateDocument(updateInfo, now), opts).
+		Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			if expectedVersion == nil {
+				return repoModel.Sighting{}, errVersionMoved
+			}
+			return repoModel.Sighting{}, r.missReason(ctx, uuid)
+		}
+		return repoModel.Sighting{}, err
+	}
+
+	err = r.record(ctx, repoConverter.NewRevision(ctx, model.RevisionActionUpdated, &before.Info, updated, now))
+	if err != nil {
+		return repoModel.Sighting{}, err
 	}
 
-	// Формируем update запрос
-	updateDoc := bson.M{
-		"$set": bson.M{
-			"updated_at": time.Now(),
-		},
+	return updated, nil
+}
+
+// errVersionMoved - документ изменили между чтением и обновлением, Update пробует снова
+var errVersionMoved = errors.New("sighting version moved")
+
+// clearedFields поля документа, которые удаляются через $unset при очистке поля наблюдения
+var clearedFields = map[model.SightingField]string{
+	model.SightingFieldObservedAt:      "info.observed_at",
+	model.SightingFieldColor:           "info.color",
+	model.SightingFieldSound:           "info.sound",
+	model.SightingFieldDurationSeconds: "info.duration_seconds",
+	model.SightingFieldCoordinates:     "info.geo",
+}
+
+// updateDocument формирует update запрос: меняются только поля, установленные в запросе,
+// очищаемые поля удаляются из документа
+func updateDocument(updateInfo model.SightingUpdateInfo, now time.Time) bson.M {
+	set := bson.M{
+		"updated_at": now,
 	}
 
-	// Обновляем поля, только если они были установлены в запросе
 	if updateInfo.ObservedAt != nil {
-		updateDoc["$set"].(bson.M)["info.observed_at"] = updateInfo.ObservedAt
+		set["info.observed_at"] = updateInfo.ObservedAt
 	}
 
 	if updateInfo.Location != nil {
-		updateDoc["$set"].(bson.M)["info.location"] = *updateInfo.Location
+		set["info.location"] = *updateInfo.Location
 	}
 
 	if updateInfo.Description != nil {
-		updateDoc["$set"].(bson.M)["info.description"] = *updateInfo.Description
+		set["info.description"] = *updateInfo.Description
 	}
 
 	if updateInfo.Color != nil {
-		updateDoc["$set"].(bson.M)["info.color"] = updateInfo.Color
+		set["info.color"] = updateInfo.Color
 	}
 
 	if updateInfo.Sound != nil {
-		updateDoc["$set"].(bson.M)["info.sound"] = updateInfo.Sound
+		set["info.sound"] = updateInfo.Sound
 	}
 
 	if updateInfo.DurationSeconds != nil {
-		updateDoc["$set"].(bson.M)["info.duration_seconds"] = updateInfo.DurationSeconds
+		set["info.duration_seconds"] = updateInfo.DurationSeconds
 	}
 
-	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": uuid}, updateDoc)
-	if err != nil {
-		return err
+	if updateInfo.Coordinates != nil {
+		set["info.geo"] = repoConverter.GeoPointToRepoModel(updateInfo.Coordinates)
+	}
+
+	update := bson.M{
+		"$set": set,
+		"$inc": bson.M{"version": 1},
+	}
+
+	if len(updateInfo.Clear) > 0 {
+		unset := bson.M{}
+		for _, field := range updateInfo.Clear {
+			unset[clearedFields[field]] = ""
+		}
+		update["$unset"] = unset
 	}
 
-	return nil
+	return update
 }
@@ -0,0 +1,121 @@
+package ufo
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/model"
+)
+
+// Коды ошибок сервера MongoDB, относящиеся к change streams
+const (
+	errCodeInvalidResumeToken        = 260
+	errCodeChangeStreamFatalError    = 280
+	errCodeChangeStreamHistoryLost   = 286
+	errCodeChangeStreamNotReplicaSet = 40573
+)
+
+// errChangeStreamClosed поток завершен сервером, например, после удаления коллекции
+var errChangeStreamClosed = errors.New("change stream closed")
+
+func (r *repository) Watch(ctx context.Context, resumeToken string) (model.SightingEventStream, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"operationType": bson.M{"$in": bson.A{
+				repoConverter.OperationTypeInsert,
+				repoConverter.OperationTypeUpdate,
+				repoConverter.OperationTypeReplace,
+				repoConverter.OperationTypeDelete,
+			}},
+		}}},
+	}
+
+	// Для обновлений сервер дочитывает актуальную версию документа
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+
+	if resumeToken != "" {
+		token, err := decodeResumeToken(resumeToken)
+		if err != nil {
+			return nil, err
+		}
+		opts.SetResumeAfter(token)
+	}
+
+	stream, err := r.collection.Watch(ctx, pipeline, opts)
+	if err != nil {
+		return nil, watchError(err)
+	}
+
+	return &changeStream{stream: stream}, nil
+}
+
+func decodeResumeToken(token string) (bson.Raw, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, model.ErrInvalidResumeToken
+	}
+
+	if bson.Raw(raw).Validate() != nil {
+		return nil, model.ErrInvalidResumeToken
+	}
+
+	return raw, nil
+}
+
+// watchError приводит ошибки change stream к доменным
+func watchError(err error) error {
+	var serverErr mongo.ServerError
+	if !errors.As(err, &serverErr) {
+		return err
+	}
+
+	switch {
+	case serverErr.HasErrorCode(errCodeChangeStreamNotReplicaSet):
+		return model.ErrWatchNotSupported
+	case serverErr.HasErrorCode(errCodeChangeStreamHistoryLost):
+		return model.ErrResumeTokenExpired
+	case serverErr.HasErrorCode(errCodeInvalidResumeToken),
+		serverErr.HasErrorCode(errCodeChangeStreamFatalError):
+		return model.ErrInvalidResumeToken
+	default:
+		return err
+	}
+}
+
+var _ model.SightingEventStream = (*changeStream)(nil)
+
+type changeStream struct {
+	stream *mongo.ChangeStream
+}
+
+func (s *changeStream) Next(ctx context.Context) (model.SightingEvent, error) {
+	if !s.stream.Next(ctx) {
+		err := s.stream.Err()
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err == nil {
+			err = errChangeStreamClosed
+		}
+		return model.SightingEvent{}, watchError(err)
+	}
+
+	var change repoModel.SightingChange
+	err := s.stream.Decode(&change)
+	if err != nil {
+		return model.SightingEvent{}, err
+	}
+
+	return repoConverter.SightingChangeToEvent(change), nil
+}
+
+func (s *changeStream) Close(ctx context.Context) error {
+	return s.stream.Close(ctx)
+}
@@ -0,0 +1,152 @@
+// Package search разбивает текст на слова и подсвечивает совпадения для полнотекстового поиска.
+// Правила разбиения совпадают с текстовым индексом MongoDB без языка (default_language "none"):
+// слово - непрерывная последовательность букв и цифр, регистр не учитывается.
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	HighlightOpen  = "<em>"
+	HighlightClose = "</em>"
+
+	// fragmentContext сколько символов до и после первого совпадения попадает во фрагмент;
+	// поле короче двух таких отрезков возвращается целиком
+	fragmentContext = 80
+
+	ellipsis = "…"
+)
+
+type token struct {
+	start int
+	end   int
+	term  string
+}
+
+// Tokenize возвращает слова текста в нижнем регистре в порядке появления (с повторами)
+func Tokenize(text string) []string {
+	toks := tokens(text)
+
+	terms := make([]string, 0, len(toks))
+	for _, tok := range toks {
+		terms = append(terms, tok.term)
+	}
+
+	return terms
+}
+
+// Terms возвращает уникальные слова текста в нижнем регистре в порядке первого появления
+func Terms(text string) []string {
+	var terms []string
+	seen := make(map[string]struct{})
+	for _, tok := range tokens(text) {
+		if _, ok := seen[tok.term]; ok {
+			continue
+		}
+		seen[tok.term] = struct{}{}
+		terms = append(terms, tok.term)
+	}
+
+	return terms
+}
+
+// Highlight оборачивает вхождения terms в тексте в <em>...</em>. Длинный текст обрезается
+// до фрагмента вокруг первого совпадения. Результат - HTML: пользовательский текст экранируется,
+// разметкой остаются только теги подсветки. Второе значение false, если совпадений нет.
+func Highlight(text string, terms []string) (string, bool) {
+	wanted := make(map[string]struct{}, len(terms))
+	for _, term := range terms {
+		wanted[strings.ToLower(term)] = struct{}{}
+	}
+
+	var matches []token
+	for _, tok := range tokens(text) {
+		if _, ok := wanted[tok.term]; ok {
+			matches = append(matches, tok)
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	from, to := 0, len(text)
+	if utf8.RuneCountInString(text) > 2*fragmentContext {
+		from = shiftRunes(text, matches[0].start, -fragmentContext)
+		to = shiftRunes(text, matches[0].end, fragmentContext)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString(ellipsis)
+	}
+
+	pos := from
+	for _, match := range matches {
+		if match.end > to {
+			break
+		}
+		if match.start < from {
+			continue
+		}
+
+		b.WriteString(html.EscapeString(text[pos:match.start]))
+		b.WriteString(HighlightOpen)
+		b.WriteString(html.EscapeString(text[match.start:match.end]))
+		b.WriteString(HighlightClose)
+		pos = match.end
+	}
+	b.WriteString(html.EscapeString(text[pos:to]))
+
+	if to < len(text) {
+		b.WriteString(ellipsis)
+	}
+
+	return b.String(), true
+}
+
+// tokens находит слова текста вместе с их байтовыми границами
+func tokens(text string) []token {
+	var toks []token
+
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		if start >= 0 {
+			toks = append(toks, token{start: start, end: i, term: strings.ToLower(text[start:i])})
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		toks = append(toks, token{start: start, end: len(text), term: strings.ToLower(text[start:])})
+	}
+
+	return toks
+}
+
+// shiftRunes сдвигает байтовую позицию pos на n символов (при n < 0 - назад),
+// не выходя за границы текста
+func shiftRunes(text string, pos, n int) int {
+	for ; n < 0 && pos > 0; n++ {
+		_, size := utf8.DecodeLastRuneInString(text[:pos])
+		pos -= size
+	}
+
+	for ; n > 0 && pos < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[pos:])
+		pos += size
+	}
+
+	return pos
+}
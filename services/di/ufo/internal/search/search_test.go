@@ -0,0 +1,49 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerms(t *testing.T) {
+	require.Equal(t,
+		[]string{"светящийся", "треугольник", "над", "алматы", "ufo"},
+		Terms("Светящийся ТРЕУГОЛЬНИК над Алматы, над UFO!"),
+	)
+	require.Empty(t, Terms(" ,.!? "))
+}
+
+func TestTokenizeKeepsRepeats(t *testing.T) {
+	require.Equal(t, []string{"шар", "и", "шар"}, Tokenize("Шар и шар"))
+}
+
+func TestHighlight(t *testing.T) {
+	fragment, ok := Highlight("Светящийся треугольник над Алматы", []string{"треугольник", "алматы"})
+	require.True(t, ok)
+	require.Equal(t, "Светящийся <em>треугольник</em> над <em>Алматы</em>", fragment)
+
+	_, ok = Highlight("Светящийся шар", []string{"треугольник"})
+	require.False(t, ok)
+}
+
+func TestHighlightEscapesHTML(t *testing.T) {
+	fragment, ok := Highlight(`<script>alert("НЛО")</script> & треугольник`, []string{"нло", "треугольник"})
+	require.True(t, ok)
+	require.Equal(t,
+		"&lt;script&gt;alert(&#34;<em>НЛО</em>&#34;)&lt;/script&gt; &amp; <em>треугольник</em>",
+		fragment,
+	)
+}
+
+func TestHighlightLongText(t *testing.T) {
+	text := strings.Repeat("пусто ", 50) + "треугольник" + strings.Repeat(" пусто", 50)
+
+	fragment, ok := Highlight(text, []string{"треугольник"})
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(fragment, ellipsis))
+	require.True(t, strings.HasSuffix(fragment, ellipsis))
+	require.Contains(t, fragment, "<em>треугольник</em>")
+	require.Less(t, len([]rune(fragment)), len([]rune(text)))
+}
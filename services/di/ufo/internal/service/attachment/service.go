@@ -0,0 +1,24 @@
+package attachment
+
+import (
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/service"
+)
+
+var _ def.AttachmentService = (*service)(nil)
+
+type service struct {
+	ufoRepository repository.UFORepository
+	storage       repository.AttachmentStorage
+
+	// maxSizeBytes максимальный размер содержимого одного вложения
+	maxSizeBytes int64
+}
+
+func NewService(ufoRepository repository.UFORepository, storage repository.AttachmentStorage, maxSizeBytes int64) *service {
+	return &service{
+		ufoRepository: ufoRepository,
+		storage:       storage,
+		maxSizeBytes:  maxSizeBytes,
+	}
+}
@@ -0,0 +1,171 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+	filesystemStorage "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/filesystem"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+// pngHeader сигнатура PNG, по которой http.DetectContentType определяет тип
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func newTestService(t *testing.T, maxSizeBytes int64) (*service, repository.UFORepository, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	storage, err := filesystemStorage.NewStorage(dir)
+	require.NoError(t, err)
+
+	repo := memoryRepository.NewRepository()
+
+	return NewService(repo, storage, maxSizeBytes), repo, dir
+}
+
+func createSighting(t *testing.T, repo repository.UFORepository) string {
+	t.Helper()
+
+	uuid, err := repo.Create(context.Background(), model.SightingInfo{Location: "Алматы", Description: "Треугольник"})
+	require.NoError(t, err)
+
+	return uuid
+}
+
+func TestUploadAndDownload(t *testing.T) {
+	ctx := context.Background()
+	s, repo, _ := newTestService(t, 1<<20)
+	sightingUuid := createSighting(t, repo)
+
+	content := append(bytes.Clone(pngHeader), bytes.Repeat([]byte{0x42}, 100_000)...)
+	sum := sha256.Sum256(content)
+
+	attachment, err := s.Upload(ctx, model.AttachmentUpload{
+		SightingUuid: sightingUuid,
+		FileName:     `C:\Users\witness\photo.png`,
+	}, bytes.NewReader(content))
+	require.NoError(t, err)
+
+	require.Equal(t, "photo.png", attachment.FileName)
+	require.Equal(t, "image/png", attachment.ContentType)
+	require.EqualValues(t, len(content), attachment.SizeBytes)
+	require.Equal(t, hex.EncodeToString(sum[:]), attachment.Sha256)
+
+	sighting, err := repo.Get(ctx, sightingUuid)
+	require.NoError(t, err)
+	require.Len(t, sighting.Attachments, 1)
+	require.Equal(t, attachment.Id, sighting.Attachments[0].Id)
+
+	downloaded, reader, err := s.Download(ctx, sightingUuid, attachment.Id)
+	require.NoError(t, err)
+	got, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, attachment.Sha256, downloaded.Sha256)
+	require.Equal(t, content, got)
+}
+
+func TestUploadRejectsNonMedia(t *testing.T) {
+	s, repo, dir := newTestService(t, 1<<20)
+
+	_, err := s.Upload(context.Background(), model.AttachmentUpload{
+		SightingUuid: createSighting(t, repo),
+		FileName:     "photo.png",
+	}, strings.NewReader("это не картинка, хоть и называется photo.png"))
+	require.ErrorIs(t, err, model.ErrUnsupportedAttachmentType)
+
+	requireEmptyDir(t, dir)
+}
+
+func TestUploadTooLarge(t *testing.T) {
+	s, repo, dir := newTestService(t, 1024)
+	sightingUuid := createSighting(t, repo)
+
+	content := append(bytes.Clone(pngHeader), make([]byte, 1024)...)
+	_, err := s.Upload(context.Background(), model.AttachmentUpload{SightingUuid: sightingUuid}, bytes.NewReader(content))
+	require.ErrorIs(t, err, model.ErrAttachmentTooLarge)
+
+	// Недогруженное содержимое не остается в хранилище, а наблюдение не меняется
+	requireEmptyDir(t, dir)
+	sighting, err := repo.Get(context.Background(), sightingUuid)
+	require.NoError(t, err)
+	require.Empty(t, sighting.Attachments)
+}
+
+func TestUploadEmpty(t *testing.T) {
+	s, repo, _ := newTestService(t, 1024)
+
+	_, err := s.Upload(context.Background(), model.AttachmentUpload{SightingUuid: createSighting(t, repo)}, bytes.NewReader(nil))
+	require.ErrorIs(t, err, model.ErrEmptyAttachment)
+}
+
+func TestUploadUnknownSighting(t *testing.T) {
+	s, _, _ := newTestService(t, 1024)
+
+	_, err := s.Upload(context.Background(), model.AttachmentUpload{SightingUuid: uuid.NewString()}, bytes.NewReader(pngHeader))
+	require.ErrorIs(t, err, model.ErrSightingNotFound)
+}
+
+func TestDownloadUnknownAttachment(t *testing.T) {
+	s, repo, _ := newTestService(t, 1024)
+
+	_, _, err := s.Download(context.Background(), createSighting(t, repo), uuid.NewString())
+	require.ErrorIs(t, err, model.ErrAttachmentNotFound)
+}
+
+func requireEmptyDir(t *testing.T, dir string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestDetectContentType(t *testing.T) {
+	// ftyp box: размер, "ftyp", основной бренд, младшая версия, совместимые бренды
+	ftyp := func(brand string) []byte {
+		return append([]byte("\x00\x00\x00\x18ftyp"+brand+"\x00\x00\x00\x00"+brand+"mif1"), make([]byte, 16)...)
+	}
+
+	tests := []struct {
+		name string
+		head []byte
+		want string
+	}{
+		{name: "heic", head: ftyp("heic"), want: "image/heic"},
+		{name: "heix", head: ftyp("heix"), want: "image/heic"},
+		{name: "mif1", head: ftyp("mif1"), want: "image/heic"},
+		{name: "quicktime", head: ftyp("qt  "), want: "video/quicktime"},
+		{name: "mp4", head: []byte("\x00\x00\x00\x18ftypisom\x00\x00\x02\x00isommp41"), want: "video/mp4"},
+		{name: "png", head: pngHeader, want: "image/png"},
+		{name: "short", head: []byte("ftyp"), want: "text/plain; charset=utf-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, detectContentType(tt.head))
+		})
+	}
+}
+
+func TestUploadAcceptsHEIC(t *testing.T) {
+	s, repo, _ := newTestService(t, 1<<20)
+
+	content := append([]byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic"), bytes.Repeat([]byte{0x42}, 1000)...)
+	attachment, err := s.Upload(context.Background(), model.AttachmentUpload{
+		SightingUuid: createSighting(t, repo),
+		FileName:     "IMG_0001.HEIC",
+	}, bytes.NewReader(content))
+	require.NoError(t, err)
+	require.Equal(t, "image/heic", attachment.ContentType)
+}
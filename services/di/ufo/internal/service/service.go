@@ -2,13 +2,38 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 )
 
 type UFOService interface {
-	Create(ctx context.Context, info model.SightingInfo) (string, error)
+	// Create создает наблюдение и возвращает вместе с ним похожие наблюдения того же события
+	Create(ctx context.Context, info model.SightingInfo) (model.CreatedSighting, error)
+	// CreateIdempotent создает наблюдение один раз на ключ: повтор с тем же ключом и теми же
+	// данными возвращает UUID первого наблюдения, с другими данными - model.ErrIdempotencyKeyReused
+	CreateIdempotent(ctx context.Context, info model.SightingInfo, idempotencyKey string) (model.CreatedSighting, error)
 	Get(ctx context.Context, uuid string) (model.Sighting, error)
-	Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo) error
-	Delete(ctx context.Context, uuid string) error
+	Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo, expectedVersion *int64) (model.Sighting, error)
+	Delete(ctx context.Context, uuid string, expectedVersion *int64) error
+	List(ctx context.Context, query model.SightingListQuery) (model.SightingList, error)
+	Restore(ctx context.Context, uuid string) error
+	Purge(ctx context.Context, olderThan time.Duration) (int64, error)
+	BatchCreate(ctx context.Context, infos []model.SightingInfo) ([]model.SightingCreateResult, error)
+	BatchGet(ctx context.Context, uuids []string) (model.SightingBatch, error)
+	Import(ctx context.Context, source model.SightingInfoSource) (model.ImportSummary, error)
+	Watch(ctx context.Context, resumeToken string) (model.SightingEventStream, error)
+	Search(ctx context.Context, text string, limit int32) ([]model.SightingSearchHit, error)
+	FindNearby(ctx context.Context, query model.NearbyQuery) ([]model.NearbySighting, error)
+	GetStats(ctx context.Context, query model.StatsQuery) (model.Stats, error)
+	GetHistory(ctx context.Context, query model.SightingHistoryQuery) (model.SightingHistory, error)
+	// Merge заполняет пустые поля основного наблюдения из дубликатов и мягко удаляет дубликаты
+	Merge(ctx context.Context, merge model.SightingMerge) (model.Sighting, error)
+}
+
+type AttachmentService interface {
+	Upload(ctx context.Context, upload model.AttachmentUpload, content io.Reader) (model.Attachment, error)
+	// Download возвращает метаданные и содержимое вложения, содержимое закрывает вызывающий
+	Download(ctx context.Context, sightingUuid, attachmentId string) (model.Attachment, io.ReadCloser, error)
 }
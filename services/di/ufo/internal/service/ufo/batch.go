@@ -0,0 +1,107 @@
+package ufo
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *service) BatchCreate(ctx context.Context, infos []model.SightingInfo) ([]model.SightingCreateResult, error) {
+	err := s.checkBatchSize(len(infos))
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверяем все элементы, чтобы вернуть нарушения сразу по всему пакету
+	v := newValidator()
+	for i, info := range infos {
+		v.prefix = fmt.Sprintf("[%d].", i)
+		v.info(info)
+	}
+
+	err = v.err()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.ufoRepository.BatchCreate(ctx, infos)
+	if err != nil {
+		return nil, err
+	}
+
+	return hideCreateErrors(ctx, results), nil
+}
+
+// hideCreateErrors заменяет ошибки хранилища по элементам пакета на model.ErrSightingNotCreated:
+// текст ошибки драйвера (например, E11000 MongoDB) остается в логе и не уходит клиенту
+func hideCreateErrors(ctx context.Context, results []model.SightingCreateResult) []model.SightingCreateResult {
+	for i, result := range results {
+		if result.Err == nil {
+			continue
+		}
+
+		logger.Error(ctx, "failed to create sighting from batch", zap.Int("index", i), zap.Error(result.Err))
+		results[i].Err = model.ErrSightingNotCreated
+	}
+
+	return results
+}
+
+func (s *service) BatchGet(ctx context.Context, uuids []string) (model.SightingBatch, error) {
+	err := s.checkBatchSize(len(uuids))
+	if err != nil {
+		return model.SightingBatch{}, err
+	}
+
+	// Повторы не запрашиваем и не возвращаем дважды
+	unique := make([]string, 0, len(uuids))
+	seen := make(map[string]struct{}, len(uuids))
+	for _, uuid := range uuids {
+		if _, ok := seen[uuid]; ok {
+			continue
+		}
+		seen[uuid] = struct{}{}
+		unique = append(unique, uuid)
+	}
+
+	found, err := s.ufoRepository.BatchGet(ctx, unique)
+	if err != nil {
+		return model.SightingBatch{}, err
+	}
+
+	byUUID := make(map[string]model.Sighting, len(found))
+	for _, sighting := range found {
+		byUUID[sighting.Uuid] = sighting
+	}
+
+	// Репозиторий не гарантирует порядок, восстанавливаем порядок запроса
+	batch := model.SightingBatch{
+		Sightings: make([]model.Sighting, 0, len(found)),
+	}
+	for _, uuid := range unique {
+		sighting, ok := byUUID[uuid]
+		if !ok {
+			batch.MissingUuids = append(batch.MissingUuids, uuid)
+			continue
+		}
+		batch.Sightings = append(batch.Sightings, sighting)
+	}
+
+	return batch, nil
+}
+
+func (s *service) checkBatchSize(size int) error {
+	if size == 0 {
+		return model.ErrEmptyBatch
+	}
+
+	if size > s.maxBatchSize {
+		return fmt.Errorf("%w: %d items, maximum is %d", model.ErrBatchTooLarge, size, s.maxBatchSize)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestBatchGetKeepsRequestOrder(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	results, err := s.BatchCreate(ctx, []model.SightingInfo{
+		{Location: "Алматы", Description: "первое"},
+		{Location: "Астана", Description: "второе"},
+	})
+	require.NoError(t, err)
+
+	first, second := results[0].Uuid, results[1].Uuid
+	missing := uuid.NewString()
+
+	batch, err := s.BatchGet(ctx, []string{second, missing, first, second})
+	require.NoError(t, err)
+
+	require.Len(t, batch.Sightings, 2)
+	require.Equal(t, second, batch.Sightings[0].Uuid)
+	require.Equal(t, first, batch.Sightings[1].Uuid)
+	require.Equal(t, []string{missing}, batch.MissingUuids)
+}
+
+func TestBatchSizeLimits(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 2, time.Hour, 0)
+
+	_, err := s.BatchCreate(ctx, nil)
+	require.ErrorIs(t, err, model.ErrEmptyBatch)
+
+	_, err = s.BatchCreate(ctx, make([]model.SightingInfo, 3))
+	require.ErrorIs(t, err, model.ErrBatchTooLarge)
+
+	_, err = s.BatchGet(ctx, []string{"a", "b", "c"})
+	require.ErrorIs(t, err, model.ErrBatchTooLarge)
+}
+
+// failingBatchRepository отвечает на BatchCreate ошибкой драйвера для каждого второго элемента
+type failingBatchRepository struct {
+	repository.UFORepository
+}
+
+func (r failingBatchRepository) BatchCreate(_ context.Context, infos []model.SightingInfo) ([]model.SightingCreateResult, error) {
+	results := make([]model.SightingCreateResult, len(infos))
+	for i := range infos {
+		if i%2 == 1 {
+			results[i].Err = errors.New(`E11000 duplicate key error collection: ufo.sightings index: _id_ dup key: { _id: "x" }`)
+			continue
+		}
+		results[i].Uuid = uuid.NewString()
+	}
+
+	return results, nil
+}
+
+func TestBatchCreateHidesStorageErrors(t *testing.T) {
+	logger.SetNopLogger()
+
+	s := NewService(failingBatchRepository{UFORepository: memoryRepository.NewRepository()}, nil, 10, time.Hour, 0)
+
+	results, err := s.BatchCreate(context.Background(), []model.SightingInfo{
+		{Location: "Алматы", Description: "первое"},
+		{Location: "Астана", Description: "второе"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, results[0].Err)
+	require.NotEmpty(t, results[0].Uuid)
+	require.ErrorIs(t, results[1].Err, model.ErrSightingNotCreated)
+	require.NotContains(t, results[1].Err.Error(), "E11000")
+}
@@ -2,15 +2,97 @@ package ufo
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"time"
 
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 )
 
-func (s *service) Create(ctx context.Context, info model.SightingInfo) (string, error) {
+// maxIdempotencyKeyLength ограничивает ключ, который клиент передает в метаданных
+const maxIdempotencyKeyLength = 255
+
+func (s *service) Create(ctx context.Context, info model.SightingInfo) (model.CreatedSighting, error) {
+	err := validateInfo(info)
+	if err != nil {
+		return model.CreatedSighting{}, err
+	}
+
+	// Дубликаты ищутся до создания, чтобы новое наблюдение не попало в кандидаты
+	duplicates, err := s.possibleDuplicates(ctx, info)
+	if err != nil {
+		return model.CreatedSighting{}, err
+	}
+
 	uuid, err := s.ufoRepository.Create(ctx, info)
+	if err != nil {
+		return model.CreatedSighting{}, err
+	}
+
+	return model.CreatedSighting{
+		Uuid:               uuid,
+		PossibleDuplicates: duplicates,
+	}, nil
+}
+
+func (s *service) CreateIdempotent(ctx context.Context, info model.SightingInfo, idempotencyKey string) (model.CreatedSighting, error) {
+	if idempotencyKey == "" || len(idempotencyKey) > maxIdempotencyKeyLength {
+		return model.CreatedSighting{}, model.ErrInvalidIdempotencyKey
+	}
+
+	err := validateInfo(info)
+	if err != nil {
+		return model.CreatedSighting{}, err
+	}
+
+	requestHash, err := infoHash(info)
+	if err != nil {
+		return model.CreatedSighting{}, err
+	}
+
+	duplicates, err := s.possibleDuplicates(ctx, info)
+	if err != nil {
+		return model.CreatedSighting{}, err
+	}
+
+	stored, err := s.ufoRepository.CreateIdempotent(ctx, info, model.IdempotencyKey{
+		Key:         idempotencyKey,
+		RequestHash: requestHash,
+		ExpiresAt:   time.Now().Add(s.idempotencyKeyTTL),
+	})
+	if err != nil {
+		return model.CreatedSighting{}, err
+	}
+
+	if stored.RequestHash != requestHash {
+		return model.CreatedSighting{}, model.ErrIdempotencyKeyReused
+	}
+
+	// При повторе наблюдение уже создано и было среди кандидатов - оно не дубликат самого себя
+	duplicates = slices.DeleteFunc(duplicates, func(sighting model.Sighting) bool {
+		return sighting.Uuid == stored.Uuid
+	})
+
+	return model.CreatedSighting{
+		Uuid:               stored.Uuid,
+		PossibleDuplicates: duplicates,
+	}, nil
+}
+
+// infoHash отпечаток данных наблюдения: одинаковые данные дают одинаковый отпечаток
+func infoHash(info model.SightingInfo) (string, error) {
+	if info.ObservedAt != nil {
+		observedAt := info.ObservedAt.UTC()
+		info.ObservedAt = &observedAt
+	}
+
+	data, err := json.Marshal(info)
 	if err != nil {
 		return "", err
 	}
 
-	return uuid, nil
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
 }
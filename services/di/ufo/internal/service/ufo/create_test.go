@@ -0,0 +1,68 @@
+package ufo
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestCreateIdempotentRepeatReturnsSameUUID(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	observedAt := time.Date(2024, 6, 15, 22, 0, 0, 0, time.UTC)
+	info := model.SightingInfo{ObservedAt: &observedAt, Location: "Алматы", Description: "Треугольник"}
+
+	first, err := s.CreateIdempotent(ctx, info, "mobile-retry-1")
+	require.NoError(t, err)
+
+	// Тот же момент в другом часовом поясе - те же данные
+	local := observedAt.In(time.FixedZone("ALMT", 5*60*60))
+	info.ObservedAt = &local
+	repeat, err := s.CreateIdempotent(ctx, info, "mobile-retry-1")
+	require.NoError(t, err)
+	require.Equal(t, first.Uuid, repeat.Uuid)
+
+	other, err := s.CreateIdempotent(ctx, info, "mobile-retry-2")
+	require.NoError(t, err)
+	require.NotEqual(t, first.Uuid, other.Uuid)
+}
+
+func TestCreateIdempotentRejectsReusedKey(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	_, err := s.CreateIdempotent(ctx, model.SightingInfo{Location: "Алматы", Description: "Треугольник"}, "key")
+	require.NoError(t, err)
+
+	_, err = s.CreateIdempotent(ctx, model.SightingInfo{Location: "Астана", Description: "Треугольник"}, "key")
+	require.ErrorIs(t, err, model.ErrIdempotencyKeyReused)
+}
+
+func TestCreateIdempotentExpiredKey(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Nanosecond, 0)
+
+	first, err := s.CreateIdempotent(ctx, model.SightingInfo{Location: "Алматы", Description: "Треугольник"}, "key")
+	require.NoError(t, err)
+
+	time.Sleep(time.Millisecond)
+
+	// После истечения ключ свободен, в том числе для других данных
+	second, err := s.CreateIdempotent(ctx, model.SightingInfo{Location: "Астана", Description: "Треугольник"}, "key")
+	require.NoError(t, err)
+	require.NotEqual(t, first.Uuid, second.Uuid)
+}
+
+func TestCreateIdempotentInvalidKey(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	_, err := s.CreateIdempotent(context.Background(), model.SightingInfo{}, strings.Repeat("k", maxIdempotencyKeyLength+1))
+	require.ErrorIs(t, err, model.ErrInvalidIdempotencyKey)
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 )
 
-func (s *service) Delete(ctx context.Context, uuid string) error {
-	err := s.ufoRepository.Delete(ctx, uuid)
+func (s *service) Delete(ctx context.Context, uuid string, expectedVersion *int64) error {
+	err := s.ufoRepository.Delete(ctx, uuid, expectedVersion)
 	if err != nil {
 		return err
 	}
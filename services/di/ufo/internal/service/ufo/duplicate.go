@@ -0,0 +1,87 @@
+package ufo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/geo"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/search"
+)
+
+const (
+	// maxDuplicateCandidates сколько наблюдений из временного окна сравнивается с новым
+	maxDuplicateCandidates = maxPageSize
+
+	// duplicateRadiusMeters наблюдения с координатами считаются сделанными в одном месте,
+	// если между ними не больше этого расстояния
+	duplicateRadiusMeters = 1000
+
+	// minDescriptionSimilarity минимальная доля общих слов в описаниях (коэффициент Жаккара)
+	minDescriptionSimilarity = 0.5
+)
+
+// possibleDuplicates ищет наблюдения того же события: время наблюдения отличается не больше
+// чем на duplicateWindow, место то же, описания похожи. Без времени наблюдения дубликаты не ищутся.
+func (s *service) possibleDuplicates(ctx context.Context, info model.SightingInfo) ([]model.Sighting, error) {
+	if s.duplicateWindow <= 0 || info.ObservedAt == nil {
+		return nil, nil
+	}
+
+	from := info.ObservedAt.Add(-s.duplicateWindow)
+	to := info.ObservedAt.Add(s.duplicateWindow)
+
+	candidates, err := s.ufoRepository.List(ctx, model.SightingListQuery{
+		Filter: model.SightingFilter{
+			ObservedFrom: &from,
+			ObservedTo:   &to,
+		},
+		PageSize: maxDuplicateCandidates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var duplicates []model.Sighting
+	for _, candidate := range candidates.Sightings {
+		if sameLocation(info, candidate.Info) && similarDescriptions(info.Description, candidate.Info.Description) {
+			duplicates = append(duplicates, candidate)
+		}
+	}
+
+	return duplicates, nil
+}
+
+// sameLocation сравнивает координаты, если они есть у обоих наблюдений, иначе - слова в названии места
+func sameLocation(a, b model.SightingInfo) bool {
+	if a.Coordinates != nil && b.Coordinates != nil {
+		return geo.Distance(*a.Coordinates, *b.Coordinates) <= duplicateRadiusMeters
+	}
+
+	location := strings.Join(search.Tokenize(a.Location), " ")
+	return location != "" && location == strings.Join(search.Tokenize(b.Location), " ")
+}
+
+// similarDescriptions проверяет, что у описаний достаточно общих слов
+func similarDescriptions(a, b string) bool {
+	termsA := search.Terms(a)
+	termsB := search.Terms(b)
+	if len(termsA) == 0 || len(termsB) == 0 {
+		return false
+	}
+
+	inA := make(map[string]struct{}, len(termsA))
+	for _, term := range termsA {
+		inA[term] = struct{}{}
+	}
+
+	common := 0
+	for _, term := range termsB {
+		if _, ok := inA[term]; ok {
+			common++
+		}
+	}
+
+	union := len(termsA) + len(termsB) - common
+	return float64(common)/float64(union) >= minDescriptionSimilarity
+}
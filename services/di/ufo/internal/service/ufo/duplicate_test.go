@@ -0,0 +1,117 @@
+package ufo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestCreateReturnsPossibleDuplicates(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, time.Hour)
+
+	observedAt := time.Date(2024, 6, 15, 22, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration) *time.Time {
+		t := observedAt.Add(offset)
+		return &t
+	}
+
+	first, err := s.Create(ctx, model.SightingInfo{
+		ObservedAt:  at(0),
+		Location:    "Алматы, Медеу",
+		Description: "Яркий треугольник над горами",
+	})
+	require.NoError(t, err)
+	require.Empty(t, first.PossibleDuplicates)
+
+	// Другое место, другое время и непохожее описание - не дубликаты
+	for _, info := range []model.SightingInfo{
+		{ObservedAt: at(10 * time.Minute), Location: "Астана", Description: "Яркий треугольник над горами"},
+		{ObservedAt: at(2 * time.Hour), Location: "Алматы, Медеу", Description: "Яркий треугольник над горами"},
+		{ObservedAt: at(10 * time.Minute), Location: "Алматы, Медеу", Description: "Медленный красный шар"},
+	} {
+		created, cerr := s.Create(ctx, info)
+		require.NoError(t, cerr)
+		require.Empty(t, created.PossibleDuplicates)
+	}
+
+	second, err := s.Create(ctx, model.SightingInfo{
+		ObservedAt:  at(-20 * time.Minute),
+		Location:    "алматы медеу",
+		Description: "Яркий треугольник над горами, без звука",
+	})
+	require.NoError(t, err)
+	require.Len(t, second.PossibleDuplicates, 1)
+	require.Equal(t, first.Uuid, second.PossibleDuplicates[0].Uuid)
+}
+
+func TestCreateComparesCoordinates(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, time.Hour)
+
+	observedAt := time.Date(2024, 6, 15, 22, 0, 0, 0, time.UTC)
+
+	first, err := s.Create(ctx, model.SightingInfo{
+		ObservedAt:  &observedAt,
+		Location:    "Медеу",
+		Description: "Светящийся диск",
+		Coordinates: &model.GeoPoint{Latitude: 43.1575, Longitude: 77.0586},
+	})
+	require.NoError(t, err)
+
+	// Место названо иначе, но координаты в пределах километра
+	near, err := s.Create(ctx, model.SightingInfo{
+		ObservedAt:  &observedAt,
+		Location:    "Каток под Алматы",
+		Description: "Светящийся диск",
+		Coordinates: &model.GeoPoint{Latitude: 43.1600, Longitude: 77.0600},
+	})
+	require.NoError(t, err)
+	require.Len(t, near.PossibleDuplicates, 1)
+	require.Equal(t, first.Uuid, near.PossibleDuplicates[0].Uuid)
+
+	far, err := s.Create(ctx, model.SightingInfo{
+		ObservedAt:  &observedAt,
+		Location:    "Медеу",
+		Description: "Светящийся диск",
+		Coordinates: &model.GeoPoint{Latitude: 43.2389, Longitude: 76.8897},
+	})
+	require.NoError(t, err)
+	require.Empty(t, far.PossibleDuplicates)
+}
+
+func TestCreateIdempotentRepeatIsNotItsOwnDuplicate(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, time.Hour)
+
+	observedAt := time.Date(2024, 6, 15, 22, 0, 0, 0, time.UTC)
+	info := model.SightingInfo{ObservedAt: &observedAt, Location: "Алматы", Description: "Треугольник"}
+
+	first, err := s.CreateIdempotent(ctx, info, "key")
+	require.NoError(t, err)
+
+	repeat, err := s.CreateIdempotent(ctx, info, "key")
+	require.NoError(t, err)
+	require.Equal(t, first.Uuid, repeat.Uuid)
+	require.Empty(t, repeat.PossibleDuplicates)
+}
+
+func TestCreateWithoutDuplicateWindow(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	observedAt := time.Date(2024, 6, 15, 22, 0, 0, 0, time.UTC)
+	info := model.SightingInfo{ObservedAt: &observedAt, Location: "Алматы", Description: "Треугольник"}
+
+	_, err := s.Create(ctx, info)
+	require.NoError(t, err)
+
+	created, err := s.Create(ctx, info)
+	require.NoError(t, err)
+	require.Empty(t, created.PossibleDuplicates)
+}
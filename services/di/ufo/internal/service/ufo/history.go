@@ -0,0 +1,29 @@
+package ufo
+
+import (
+	"context"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/history"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *service) GetHistory(ctx context.Context, query model.SightingHistoryQuery) (model.SightingHistory, error) {
+	revisions, err := s.ufoRepository.History(ctx, query.Uuid)
+	if err != nil {
+		return model.SightingHistory{}, err
+	}
+
+	result := model.SightingHistory{
+		Revisions: revisions,
+	}
+
+	if query.AsOf != nil {
+		sighting, ok := history.AsOf(revisions, *query.AsOf)
+		if !ok {
+			return model.SightingHistory{}, model.ErrNoSightingAsOf
+		}
+		result.AsOf = &sighting
+	}
+
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package ufo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestGetHistoryAsOf(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	beforeCreate := time.Now().Add(-time.Second)
+	created, err := s.Create(ctx, model.SightingInfo{
+		Location:    "Алматы",
+		Description: "Треугольник",
+	})
+	require.NoError(t, err)
+	id := created.Uuid
+
+	afterCreate := time.Now()
+	time.Sleep(time.Millisecond)
+
+	description := "Диск"
+	_, err = s.Update(ctx, id, model.SightingUpdateInfo{Description: &description}, nil)
+	require.NoError(t, err)
+
+	history, err := s.GetHistory(ctx, model.SightingHistoryQuery{Uuid: id})
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 2)
+	require.Nil(t, history.AsOf)
+
+	history, err = s.GetHistory(ctx, model.SightingHistoryQuery{Uuid: id, AsOf: &afterCreate})
+	require.NoError(t, err)
+	require.NotNil(t, history.AsOf)
+	require.Equal(t, "Треугольник", history.AsOf.Info.Description)
+	require.Equal(t, int64(1), history.AsOf.Version)
+
+	_, err = s.GetHistory(ctx, model.SightingHistoryQuery{Uuid: id, AsOf: &beforeCreate})
+	require.ErrorIs(t, err, model.ErrNoSightingAsOf)
+
+	_, err = s.GetHistory(ctx, model.SightingHistoryQuery{Uuid: "00000000-0000-0000-0000-000000000000"})
+	require.ErrorIs(t, err, model.ErrSightingNotFound)
+}
@@ -0,0 +1,118 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+// maxImportRejections сколько отказов с причинами попадает в итог импорта,
+// чтобы ответ на многогигабайтный архив оставался ограниченным по размеру
+const maxImportRejections = 1000
+
+func (s *service) Import(ctx context.Context, source model.SightingInfoSource) (model.ImportSummary, error) {
+	start := time.Now()
+
+	imp := importer{
+		service: s,
+		batch:   make([]model.SightingInfo, 0, s.maxBatchSize),
+		indexes: make([]int64, 0, s.maxBatchSize),
+	}
+
+	for index := int64(0); ; index++ {
+		info, err := source.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.Is(err, model.ErrInvalidImportItem) {
+			imp.summary.ReceivedCount++
+			imp.reject(index, err.Error())
+			continue
+		}
+		if err != nil {
+			return model.ImportSummary{}, imp.abort(err)
+		}
+
+		imp.summary.ReceivedCount++
+		err = validateInfo(info)
+		if err != nil {
+			imp.reject(index, err.Error())
+			continue
+		}
+
+		imp.batch = append(imp.batch, info)
+		imp.indexes = append(imp.indexes, index)
+
+		if len(imp.batch) < s.maxBatchSize {
+			continue
+		}
+
+		err = imp.flush(ctx)
+		if err != nil {
+			return model.ImportSummary{}, imp.abort(err)
+		}
+	}
+
+	err := imp.flush(ctx)
+	if err != nil {
+		return model.ImportSummary{}, imp.abort(err)
+	}
+
+	imp.summary.Duration = time.Since(start)
+
+	return imp.summary, nil
+}
+
+// importer накапливает пакет наблюдений и итог одного импорта
+type importer struct {
+	service *service
+	summary model.ImportSummary
+
+	batch []model.SightingInfo
+	// indexes номера элементов batch в источнике
+	indexes []int64
+}
+
+func (imp *importer) flush(ctx context.Context) error {
+	if len(imp.batch) == 0 {
+		return nil
+	}
+
+	results, err := imp.service.ufoRepository.BatchCreate(ctx, imp.batch)
+	if err != nil {
+		return err
+	}
+
+	for i, result := range hideCreateErrors(ctx, results) {
+		if result.Err != nil {
+			imp.reject(imp.indexes[i], result.Err.Error())
+			continue
+		}
+		imp.summary.InsertedCount++
+	}
+
+	imp.batch = imp.batch[:0]
+	imp.indexes = imp.indexes[:0]
+
+	return nil
+}
+
+func (imp *importer) reject(index int64, reason string) {
+	imp.summary.RejectedCount++
+	if len(imp.summary.Rejections) < maxImportRejections {
+		imp.summary.Rejections = append(imp.summary.Rejections, model.ImportRejection{
+			Index:  index,
+			Reason: reason,
+		})
+	}
+}
+
+// abort дополняет ошибку прерванного импорта количеством уже сохраненных
+// наблюдений: они не откатываются
+func (imp *importer) abort(err error) error {
+	return fmt.Errorf("import aborted after %d inserted sightings: %w", imp.summary.InsertedCount, err)
+}
@@ -0,0 +1,71 @@
+package ufo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+// sliceSource источник импорта из заранее подготовленных элементов,
+// nil элемент имитирует некорректное сообщение
+type sliceSource struct {
+	items []*model.SightingInfo
+}
+
+func (s *sliceSource) Next(_ context.Context) (model.SightingInfo, error) {
+	if len(s.items) == 0 {
+		return model.SightingInfo{}, io.EOF
+	}
+
+	item := s.items[0]
+	s.items = s.items[1:]
+	if item == nil {
+		return model.SightingInfo{}, fmt.Errorf("%w: info is empty", model.ErrInvalidImportItem)
+	}
+
+	return *item, nil
+}
+
+func TestImportWritesInBatches(t *testing.T) {
+	ctx := context.Background()
+	repo := memoryRepository.NewRepository()
+	s := NewService(repo, nil, 2, time.Hour, 0)
+
+	source := &sliceSource{items: []*model.SightingInfo{
+		{Location: "Алматы", Description: "первое"},
+		nil,
+		{Location: "Астана", Description: "второе"},
+		{Location: "Шымкент", Description: "третье"},
+		nil,
+	}}
+
+	summary, err := s.Import(ctx, source)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 5, summary.ReceivedCount)
+	require.EqualValues(t, 3, summary.InsertedCount)
+	require.EqualValues(t, 2, summary.RejectedCount)
+	require.Len(t, summary.Rejections, 2)
+	require.EqualValues(t, 1, summary.Rejections[0].Index)
+	require.EqualValues(t, 4, summary.Rejections[1].Index)
+
+	list, err := s.List(ctx, model.SightingListQuery{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, list.Sightings, 3)
+}
+
+func TestImportEmptySource(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 2, time.Hour, 0)
+
+	summary, err := s.Import(context.Background(), &sliceSource{})
+	require.NoError(t, err)
+	require.Zero(t, summary.ReceivedCount)
+	require.Zero(t, summary.InsertedCount)
+}
@@ -0,0 +1,28 @@
+package ufo
+
+import (
+	"context"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+func (s *service) List(ctx context.Context, query model.SightingListQuery) (model.SightingList, error) {
+	switch {
+	case query.PageSize <= 0:
+		query.PageSize = defaultPageSize
+	case query.PageSize > maxPageSize:
+		query.PageSize = maxPageSize
+	}
+
+	list, err := s.ufoRepository.List(ctx, query)
+	if err != nil {
+		return model.SightingList{}, err
+	}
+
+	return list, nil
+}
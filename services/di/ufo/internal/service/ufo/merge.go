@@ -0,0 +1,116 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func (s *service) Merge(ctx context.Context, merge model.SightingMerge) (model.Sighting, error) {
+	err := s.checkMerge(merge)
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	// Если клиент не передал версию, изменение основного наблюдения между чтением и объединением
+	// не ошибка - перечитываем его и пробуем снова
+	for {
+		target, err := s.ufoRepository.Get(ctx, merge.TargetUuid)
+		if err != nil {
+			return model.Sighting{}, fmt.Errorf("sighting %s: %w", merge.TargetUuid, err)
+		}
+
+		expectedVersion := merge.ExpectedVersion
+		if expectedVersion == nil {
+			expectedVersion = &target.Version
+		}
+
+		// Отсутствующие и удаленные дубликаты здесь пропускаются, ошибку по ним вернет репозиторий
+		duplicates, err := s.BatchGet(ctx, merge.DuplicateUuids)
+		if err != nil {
+			return model.Sighting{}, err
+		}
+
+		merged, err := s.ufoRepository.Merge(
+			ctx,
+			merge.TargetUuid,
+			mergeUpdate(target.Info, duplicates.Sightings),
+			expectedVersion,
+			merge.DuplicateUuids,
+		)
+		if errors.Is(err, model.ErrVersionConflict) && merge.ExpectedVersion == nil {
+			continue
+		}
+		if err != nil {
+			return model.Sighting{}, err
+		}
+
+		return merged, nil
+	}
+}
+
+func (s *service) checkMerge(merge model.SightingMerge) error {
+	if len(merge.DuplicateUuids) == 0 {
+		return fmt.Errorf("%w: no duplicates to merge", model.ErrInvalidMerge)
+	}
+
+	if len(merge.DuplicateUuids) > s.maxBatchSize {
+		return fmt.Errorf("%w: %d duplicates, maximum is %d", model.ErrInvalidMerge, len(merge.DuplicateUuids), s.maxBatchSize)
+	}
+
+	seen := make(map[string]struct{}, len(merge.DuplicateUuids))
+	for _, uuid := range merge.DuplicateUuids {
+		if uuid == merge.TargetUuid {
+			return fmt.Errorf("%w: sighting %s cannot be merged into itself", model.ErrInvalidMerge, uuid)
+		}
+
+		if _, ok := seen[uuid]; ok {
+			return fmt.Errorf("%w: duplicate %s is listed twice", model.ErrInvalidMerge, uuid)
+		}
+		seen[uuid] = struct{}{}
+	}
+
+	return nil
+}
+
+// mergeUpdate заполняет пустые поля основного наблюдения первым непустым значением из дубликатов
+// в порядке запроса; заполненные поля основного наблюдения не меняются
+func mergeUpdate(target model.SightingInfo, duplicates []model.Sighting) model.SightingUpdateInfo {
+	var update model.SightingUpdateInfo
+
+	for _, duplicate := range duplicates {
+		info := duplicate.Info
+
+		if target.ObservedAt == nil && update.ObservedAt == nil {
+			update.ObservedAt = info.ObservedAt
+		}
+
+		if target.Location == "" && update.Location == nil && info.Location != "" {
+			update.Location = &info.Location
+		}
+
+		if target.Description == "" && update.Description == nil && info.Description != "" {
+			update.Description = &info.Description
+		}
+
+		if target.Color == nil && update.Color == nil {
+			update.Color = info.Color
+		}
+
+		if target.Sound == nil && update.Sound == nil {
+			update.Sound = info.Sound
+		}
+
+		if target.DurationSeconds == nil && update.DurationSeconds == nil {
+			update.DurationSeconds = info.DurationSeconds
+		}
+
+		if target.Coordinates == nil && update.Coordinates == nil {
+			update.Coordinates = info.Coordinates
+		}
+	}
+
+	return update
+}
@@ -0,0 +1,85 @@
+package ufo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestMergeFillsEmptyFields(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	red, orange, sound := "красный", "оранжевый", true
+	duration := int32(120)
+
+	target, err := s.Create(ctx, model.SightingInfo{Location: "Алматы", Description: "Треугольник", Color: &red})
+	require.NoError(t, err)
+	first, err := s.Create(ctx, model.SightingInfo{Location: "Алматы", Description: "Огни", Color: &orange, Sound: &sound})
+	require.NoError(t, err)
+	second, err := s.Create(ctx, model.SightingInfo{Location: "Алматы", Description: "Огни", DurationSeconds: &duration})
+	require.NoError(t, err)
+
+	merged, err := s.Merge(ctx, model.SightingMerge{
+		TargetUuid:     target.Uuid,
+		DuplicateUuids: []string{first.Uuid, second.Uuid},
+	})
+	require.NoError(t, err)
+
+	// Заполненные поля основного наблюдения не меняются, пустые берутся из дубликатов
+	require.Equal(t, "Треугольник", merged.Info.Description)
+	require.Equal(t, red, *merged.Info.Color)
+	require.Equal(t, sound, *merged.Info.Sound)
+	require.Equal(t, duration, *merged.Info.DurationSeconds)
+	require.Equal(t, int64(2), merged.Version)
+
+	batch, err := s.BatchGet(ctx, []string{first.Uuid, second.Uuid})
+	require.NoError(t, err)
+	require.Empty(t, batch.Sightings)
+}
+
+func TestMergeExpectedVersion(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	target, err := s.Create(ctx, model.SightingInfo{Location: "Алматы", Description: "Огни"})
+	require.NoError(t, err)
+	duplicate, err := s.Create(ctx, model.SightingInfo{Location: "Алматы", Description: "Огни"})
+	require.NoError(t, err)
+
+	version := int64(3)
+	_, err = s.Merge(ctx, model.SightingMerge{
+		TargetUuid:      target.Uuid,
+		DuplicateUuids:  []string{duplicate.Uuid},
+		ExpectedVersion: &version,
+	})
+	require.ErrorIs(t, err, model.ErrVersionConflict)
+}
+
+func TestMergeInvalid(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 2, time.Hour, 0)
+
+	tests := []struct {
+		name       string
+		duplicates []string
+	}{
+		{name: "no duplicates"},
+		{name: "target among duplicates", duplicates: []string{"target"}},
+		{name: "repeated duplicate", duplicates: []string{"a", "a"}},
+		{name: "too many duplicates", duplicates: []string{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.Merge(context.Background(), model.SightingMerge{
+				TargetUuid:     "target",
+				DuplicateUuids: tt.duplicates,
+			})
+			require.ErrorIs(t, err, model.ErrInvalidMerge)
+		})
+	}
+}
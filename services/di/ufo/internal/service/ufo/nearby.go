@@ -0,0 +1,50 @@
+package ufo
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/geo"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+const (
+	defaultNearbyLimit = 20
+	maxNearbyLimit     = 100
+)
+
+func (s *service) FindNearby(ctx context.Context, query model.NearbyQuery) ([]model.NearbySighting, error) {
+	err := checkCoordinates(&query.Center)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.RadiusMeters <= 0 || math.IsNaN(query.RadiusMeters) || math.IsInf(query.RadiusMeters, 1) {
+		return nil, fmt.Errorf("%w: radius must be positive, got %v", model.ErrInvalidRadius, query.RadiusMeters)
+	}
+
+	switch {
+	case query.Limit <= 0:
+		query.Limit = defaultNearbyLimit
+	case query.Limit > maxNearbyLimit:
+		query.Limit = maxNearbyLimit
+	}
+
+	sightings, err := s.ufoRepository.FindNearby(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return sightings, nil
+}
+
+// checkCoordinates проверяет координаты наблюдения, отсутствующие координаты допустимы
+func checkCoordinates(point *model.GeoPoint) error {
+	if point == nil || geo.Valid(*point) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: latitude %v, longitude %v, expected latitude in [-90, 90] and longitude in [-180, 180]",
+		model.ErrInvalidCoordinates, point.Latitude, point.Longitude)
+}
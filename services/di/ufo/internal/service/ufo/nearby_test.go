@@ -0,0 +1,63 @@
+package ufo
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestCreateRejectsInvalidCoordinates(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	_, err := s.Create(context.Background(), model.SightingInfo{
+		Location:    "Алматы",
+		Description: "Треугольник",
+		Coordinates: &model.GeoPoint{Latitude: 91, Longitude: 76.8897},
+	})
+	require.ErrorIs(t, err, model.ErrInvalidCoordinates)
+}
+
+func TestBatchCreateRejectsInvalidCoordinates(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	_, err := s.BatchCreate(context.Background(), []model.SightingInfo{
+		{Location: "Алматы", Description: "Треугольник"},
+		{Location: "Алматы", Description: "Шар", Coordinates: &model.GeoPoint{Latitude: 43.2389, Longitude: 181}},
+	})
+	require.ErrorIs(t, err, model.ErrInvalidCoordinates)
+	require.ErrorContains(t, err, "[1].coordinates")
+}
+
+func TestFindNearbyValidation(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	_, err := s.FindNearby(ctx, model.NearbyQuery{Center: model.GeoPoint{Latitude: -91}, RadiusMeters: 1000})
+	require.ErrorIs(t, err, model.ErrInvalidCoordinates)
+
+	for _, radius := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		_, err = s.FindNearby(ctx, model.NearbyQuery{RadiusMeters: radius})
+		require.ErrorIs(t, err, model.ErrInvalidRadius)
+	}
+}
+
+func TestImportRejectsInvalidCoordinates(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	source := &sliceSource{items: []*model.SightingInfo{
+		{Location: "Алматы", Description: "первое", Coordinates: &model.GeoPoint{Latitude: 43.2389, Longitude: 76.8897}},
+		{Location: "Нигде", Description: "второе", Coordinates: &model.GeoPoint{Latitude: 100, Longitude: 0}},
+	}}
+
+	summary, err := s.Import(context.Background(), source)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, summary.InsertedCount)
+	require.EqualValues(t, 1, summary.RejectedCount)
+	require.EqualValues(t, 1, summary.Rejections[0].Index)
+}
@@ -0,0 +1,38 @@
+package ufo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+)
+
+func (s *service) Purge(ctx context.Context, olderThan time.Duration) (int64, error) {
+	deletedBefore := time.Now().Add(-olderThan)
+
+	purged, err := s.ufoRepository.Purge(ctx, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	// Наблюдения уже удалены, поэтому сбой удаления содержимого не делает Purge неуспешным:
+	// оставшиеся файлы видны в логе
+	var failed int
+	for _, id := range purged.AttachmentIds {
+		err = s.attachmentStorage.Delete(ctx, id)
+		if err != nil {
+			failed++
+			logger.Warn(ctx, "failed to delete purged attachment content", zap.String("attachment_id", id), zap.Error(err))
+		}
+	}
+
+	logger.Info(ctx, "purged deleted sightings",
+		zap.Time("deleted_before", deletedBefore),
+		zap.Int64("purged_count", purged.PurgedCount),
+		zap.Int("attachments_deleted", len(purged.AttachmentIds)-failed),
+	)
+
+	return purged.PurgedCount, nil
+}
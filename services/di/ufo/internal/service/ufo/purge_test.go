@@ -0,0 +1,53 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/platform/pkg/logger"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/filesystem"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestPurgeDeletesAttachmentContent(t *testing.T) {
+	logger.SetNopLogger()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage, err := filesystem.NewStorage(dir)
+	require.NoError(t, err)
+
+	repo := memoryRepository.NewRepository()
+	s := NewService(repo, storage, 10, time.Hour, 0)
+
+	kept, err := repo.Create(ctx, model.SightingInfo{Location: "Алматы", Description: "Треугольник"})
+	require.NoError(t, err)
+	purged, err := repo.Create(ctx, model.SightingInfo{Location: "Астана", Description: "Диск"})
+	require.NoError(t, err)
+
+	for uuid, id := range map[string]string{kept: "kept-photo", purged: "purged-photo"} {
+		require.NoError(t, storage.Save(ctx, id, strings.NewReader("jpeg")))
+		_, err = repo.AddAttachment(ctx, uuid, model.Attachment{Id: id, FileName: id + ".jpg", CreatedAt: time.Now()})
+		require.NoError(t, err)
+	}
+	require.NoError(t, repo.Delete(ctx, purged, nil))
+
+	// Граница в будущем: удаляется все мягко удаленное
+	count, err := s.Purge(ctx, -time.Second)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	_, err = os.Stat(filepath.Join(dir, "purged-photo"))
+	require.True(t, errors.Is(err, fs.ErrNotExist), "content of purged sighting must be deleted")
+	_, err = os.Stat(filepath.Join(dir, "kept-photo"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,14 @@
+package ufo
+
+import (
+	"context"
+)
+
+func (s *service) Restore(ctx context.Context, uuid string) error {
+	err := s.ufoRepository.Restore(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package ufo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestSearchHighlights(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	created, err := s.Create(ctx, model.SightingInfo{
+		Location:    "Алматы, Медеу",
+		Description: "Светящийся треугольник над горами",
+	})
+	require.NoError(t, err)
+
+	hits, err := s.Search(ctx, "Треугольник около Алматы", 0)
+	require.NoError(t, err)
+
+	require.Len(t, hits, 1)
+	require.Equal(t, created.Uuid, hits[0].Sighting.Uuid)
+	require.Equal(t, []model.SearchHighlight{
+		{Field: "location", Fragment: "<em>Алматы</em>, Медеу"},
+		{Field: "description", Fragment: "Светящийся <em>треугольник</em> над горами"},
+	}, hits[0].Highlights)
+}
+
+func TestSearchEmptyQuery(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	_, err := s.Search(context.Background(), " ?! ", 0)
+	require.ErrorIs(t, err, model.ErrEmptySearchQuery)
+}
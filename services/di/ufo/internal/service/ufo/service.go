@@ -1,6 +1,8 @@
 package ufo
 
 import (
+	"time"
+
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/repository"
 	def "github.com/baizhigit/go-ms-examples/di/ufo/internal/service"
 )
@@ -9,10 +11,34 @@ var _ def.UFOService = (*service)(nil)
 
 type service struct {
 	ufoRepository repository.UFORepository
+
+	// attachmentStorage содержимое вложений, которое Purge удаляет вместе с наблюдениями
+	attachmentStorage repository.AttachmentStorage
+
+	// maxBatchSize максимальное количество элементов в BatchCreate и BatchGet,
+	// с тем же размером пакета Import пишет наблюдения в репозиторий
+	maxBatchSize int
+
+	// idempotencyKeyTTL сколько хранится ключ идемпотентности Create
+	idempotencyKeyTTL time.Duration
+
+	// duplicateWindow насколько могут различаться времена наблюдений одного события,
+	// 0 отключает поиск дубликатов при создании
+	duplicateWindow time.Duration
 }
 
-func NewService(ufoRepository repository.UFORepository) *service {
+func NewService(
+	ufoRepository repository.UFORepository,
+	attachmentStorage repository.AttachmentStorage,
+	maxBatchSize int,
+	idempotencyKeyTTL time.Duration,
+	duplicateWindow time.Duration,
+) *service {
 	return &service{
-		ufoRepository: ufoRepository,
+		ufoRepository:     ufoRepository,
+		attachmentStorage: attachmentStorage,
+		maxBatchSize:      maxBatchSize,
+		idempotencyKeyTTL: idempotencyKeyTTL,
+		duplicateWindow:   duplicateWindow,
 	}
 }
@@ -0,0 +1,100 @@
+package ufo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func createObservedAt(t *testing.T, s *service, observedAt time.Time, color string) {
+	t.Helper()
+
+	_, err := s.Create(context.Background(), model.SightingInfo{
+		ObservedAt:  &observedAt,
+		Location:    "Алматы",
+		Description: "Треугольник",
+		Color:       &color,
+	})
+	require.NoError(t, err)
+}
+
+func statsKeys(stats model.Stats) ([]string, []int64) {
+	keys := make([]string, 0, len(stats.Buckets))
+	counts := make([]int64, 0, len(stats.Buckets))
+	for _, bucket := range stats.Buckets {
+		keys = append(keys, bucket.Key)
+		counts = append(counts, bucket.Count)
+	}
+
+	return keys, counts
+}
+
+func TestGetStatsFillsGaps(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	createObservedAt(t, s, time.Date(2024, 6, 11, 10, 0, 0, 0, time.UTC), "зеленый")
+	createObservedAt(t, s, time.Date(2024, 6, 13, 10, 0, 0, 0, time.UTC), "зеленый")
+	createObservedAt(t, s, time.Date(2024, 6, 13, 18, 0, 0, 0, time.UTC), "красный")
+
+	stats, err := s.GetStats(ctx, model.StatsQuery{GroupBy: model.StatsGroupByDay})
+	require.NoError(t, err)
+
+	keys, counts := statsKeys(stats)
+	require.Equal(t, []string{"2024-06-11", "2024-06-12", "2024-06-13"}, keys)
+	require.Equal(t, []int64{1, 0, 2}, counts)
+	require.EqualValues(t, 3, stats.Total)
+
+	// Запрошенный период задает границы ряда, to не включается
+	from := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	stats, err = s.GetStats(ctx, model.StatsQuery{ObservedFrom: &from, ObservedTo: &to, GroupBy: model.StatsGroupByDay})
+	require.NoError(t, err)
+
+	keys, counts = statsKeys(stats)
+	require.Equal(t, []string{"2024-06-10", "2024-06-11", "2024-06-12", "2024-06-13", "2024-06-14"}, keys)
+	require.Equal(t, []int64{0, 1, 0, 2, 0}, counts)
+}
+
+func TestGetStatsByColorSortedByCount(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	observedAt := time.Date(2024, 6, 11, 10, 0, 0, 0, time.UTC)
+	createObservedAt(t, s, observedAt, "красный")
+	createObservedAt(t, s, observedAt, "зеленый")
+	createObservedAt(t, s, observedAt, "зеленый")
+
+	stats, err := s.GetStats(context.Background(), model.StatsQuery{GroupBy: model.StatsGroupByColor})
+	require.NoError(t, err)
+
+	keys, counts := statsKeys(stats)
+	require.Equal(t, []string{"зеленый", "красный"}, keys)
+	require.Equal(t, []int64{2, 1}, counts)
+}
+
+func TestGetStatsValidation(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	_, err := s.GetStats(ctx, model.StatsQuery{})
+	require.ErrorIs(t, err, model.ErrInvalidStatsGroupBy)
+
+	from := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	_, err = s.GetStats(ctx, model.StatsQuery{ObservedFrom: &from, ObservedTo: &from, GroupBy: model.StatsGroupByDay})
+	require.ErrorIs(t, err, model.ErrInvalidStatsRange)
+
+	to := from.AddDate(10, 0, 0)
+	_, err = s.GetStats(ctx, model.StatsQuery{ObservedFrom: &from, ObservedTo: &to, GroupBy: model.StatsGroupByDay})
+	require.ErrorIs(t, err, model.ErrStatsRangeTooLarge)
+
+	stats, err := s.GetStats(ctx, model.StatsQuery{ObservedFrom: &from, ObservedTo: &to, GroupBy: model.StatsGroupByMonth})
+	require.NoError(t, err)
+	// С 10 июня 2024 по 10 июня 2034 ряд задевает 121 месяц, включая неполные крайние
+	require.Len(t, stats.Buckets, 121)
+	require.Zero(t, stats.Total)
+}
@@ -6,11 +6,16 @@ import (
 	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
 )
 
-func (s *service) Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo) error {
-	err := s.ufoRepository.Update(ctx, uuid, updateInfo)
+func (s *service) Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo, expectedVersion *int64) (model.Sighting, error) {
+	err := validateUpdate(updateInfo)
 	if err != nil {
-		return err
+		return model.Sighting{}, err
 	}
 
-	return nil
+	sighting, err := s.ufoRepository.Update(ctx, uuid, updateInfo, expectedVersion)
+	if err != nil {
+		return model.Sighting{}, err
+	}
+
+	return sighting, nil
 }
@@ -0,0 +1,149 @@
+package ufo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/geo"
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+const (
+	maxLocationLength    = 200
+	maxDescriptionLength = 2000
+
+	// maxClockSkew насколько observed_at может опережать часы сервера: часы клиента могут спешить
+	maxClockSkew = 5 * time.Minute
+)
+
+// knownColors допустимые цвета объекта, сравниваются без учета регистра и различия "е" и "ё"
+var knownColors = map[string]struct{}{
+	"белый":        {},
+	"черный":       {},
+	"серый":        {},
+	"серебристый":  {},
+	"красный":      {},
+	"оранжевый":    {},
+	"желтый":       {},
+	"золотистый":   {},
+	"зеленый":      {},
+	"голубой":      {},
+	"синий":        {},
+	"фиолетовый":   {},
+	"розовый":      {},
+	"коричневый":   {},
+	"разноцветный": {},
+}
+
+// validator собирает нарушения, чтобы клиент узнал обо всех ошибках за один запрос
+type validator struct {
+	now time.Time
+	// prefix добавляется к имени поля, например "[2]." для элемента пакета
+	prefix     string
+	violations []model.FieldViolation
+}
+
+func newValidator() *validator {
+	return &validator{now: time.Now()}
+}
+
+func (v *validator) add(field string, err error, format string, args ...any) {
+	v.violations = append(v.violations, model.FieldViolation{
+		Field:       v.prefix + field,
+		Description: fmt.Sprintf(format, args...),
+		Err:         err,
+	})
+}
+
+// err возвращает *model.ValidationError или nil, если нарушений нет
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	return &model.ValidationError{Violations: v.violations}
+}
+
+// validateInfo проверяет данные нового наблюдения
+func validateInfo(info model.SightingInfo) error {
+	v := newValidator()
+	v.info(info)
+
+	return v.err()
+}
+
+func (v *validator) info(info model.SightingInfo) {
+	v.observedAt(info.ObservedAt)
+	v.location(info.Location)
+	v.description(info.Description)
+	v.color(info.Color)
+	v.durationSeconds(info.DurationSeconds)
+	v.coordinates(info.Coordinates)
+}
+
+// validateUpdate проверяет только изменяемые поля; очистка необязательных полей всегда допустима
+func validateUpdate(updateInfo model.SightingUpdateInfo) error {
+	v := newValidator()
+
+	v.observedAt(updateInfo.ObservedAt)
+	if updateInfo.Location != nil {
+		v.location(*updateInfo.Location)
+	}
+	if updateInfo.Description != nil {
+		v.description(*updateInfo.Description)
+	}
+	v.color(updateInfo.Color)
+	v.durationSeconds(updateInfo.DurationSeconds)
+	v.coordinates(updateInfo.Coordinates)
+
+	return v.err()
+}
+
+func (v *validator) observedAt(observedAt *time.Time) {
+	if observedAt != nil && observedAt.After(v.now.Add(maxClockSkew)) {
+		v.add("observed_at", nil, "must not be in the future, got %s", observedAt.UTC().Format(time.RFC3339))
+	}
+}
+
+func (v *validator) location(location string) {
+	if length := utf8.RuneCountInString(location); length > maxLocationLength {
+		v.add("location", nil, "must be at most %d characters, got %d", maxLocationLength, length)
+	}
+}
+
+func (v *validator) description(description string) {
+	if strings.TrimSpace(description) == "" {
+		v.add("description", nil, "must not be empty")
+		return
+	}
+
+	if length := utf8.RuneCountInString(description); length > maxDescriptionLength {
+		v.add("description", nil, "must be at most %d characters, got %d", maxDescriptionLength, length)
+	}
+}
+
+func (v *validator) color(color *string) {
+	if color == nil {
+		return
+	}
+
+	normalized := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(*color)), "ё", "е")
+	if _, ok := knownColors[normalized]; !ok {
+		v.add("color", nil, "unknown color %q", *color)
+	}
+}
+
+func (v *validator) durationSeconds(durationSeconds *int32) {
+	if durationSeconds != nil && *durationSeconds < 0 {
+		v.add("duration_seconds", nil, "must not be negative, got %d", *durationSeconds)
+	}
+}
+
+func (v *validator) coordinates(point *model.GeoPoint) {
+	if point != nil && !geo.Valid(*point) {
+		v.add("coordinates", model.ErrInvalidCoordinates,
+			"latitude must be in [-90, 90] and longitude in [-180, 180], got %v, %v", point.Latitude, point.Longitude)
+	}
+}
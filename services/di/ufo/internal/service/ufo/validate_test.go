@@ -0,0 +1,109 @@
+package ufo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+	memoryRepository "github.com/baizhigit/go-ms-examples/di/ufo/internal/repository/memory"
+)
+
+func TestValidateInfo(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	recent := time.Now().Add(time.Minute)
+	negative := int32(-1)
+
+	tests := []struct {
+		name   string
+		info   model.SightingInfo
+		fields []string
+	}{
+		{
+			name: "valid",
+			info: model.SightingInfo{ObservedAt: &recent, Location: "Алматы", Description: "Треугольник", Color: ptr("Жёлтый")},
+		},
+		{
+			name:   "future observed_at",
+			info:   model.SightingInfo{ObservedAt: &future, Description: "Треугольник"},
+			fields: []string{"observed_at"},
+		},
+		{
+			name:   "blank description",
+			info:   model.SightingInfo{Location: "Алматы", Description: "  "},
+			fields: []string{"description"},
+		},
+		{
+			name: "everything wrong",
+			info: model.SightingInfo{
+				Location:        strings.Repeat("м", maxLocationLength+1),
+				Description:     strings.Repeat("о", maxDescriptionLength+1),
+				Color:           ptr("бирюзово-малиновый"),
+				DurationSeconds: &negative,
+				Coordinates:     &model.GeoPoint{Latitude: 91},
+			},
+			fields: []string{"location", "description", "color", "duration_seconds", "coordinates"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateInfo(tt.info)
+			if len(tt.fields) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, model.ErrInvalidSighting)
+			require.Equal(t, tt.fields, violationFields(t, err))
+		})
+	}
+}
+
+func TestValidateUpdateChecksOnlyChangedFields(t *testing.T) {
+	require.NoError(t, validateUpdate(model.SightingUpdateInfo{
+		Color: ptr("синий"),
+		Clear: []model.SightingField{model.SightingFieldObservedAt, model.SightingFieldColor},
+	}))
+
+	err := validateUpdate(model.SightingUpdateInfo{Description: ptr("")})
+	require.ErrorIs(t, err, model.ErrInvalidSighting)
+	require.Equal(t, []string{"description"}, violationFields(t, err))
+}
+
+func TestBatchCreateReportsAllItems(t *testing.T) {
+	s := NewService(memoryRepository.NewRepository(), nil, 10, time.Hour, 0)
+
+	_, err := s.BatchCreate(context.Background(), []model.SightingInfo{
+		{Description: ""},
+		{Description: "Треугольник"},
+		{Description: "Треугольник", Color: ptr("цвет морской волны")},
+	})
+	require.ErrorIs(t, err, model.ErrInvalidSighting)
+	require.Equal(t, []string{"[0].description", "[2].color"}, violationFields(t, err))
+
+	list, err := s.List(context.Background(), model.SightingListQuery{})
+	require.NoError(t, err)
+	require.Empty(t, list.Sightings)
+}
+
+func violationFields(t *testing.T, err error) []string {
+	t.Helper()
+
+	var verr *model.ValidationError
+	require.True(t, errors.As(err, &verr))
+
+	fields := make([]string, 0, len(verr.Violations))
+	for _, violation := range verr.Violations {
+		fields = append(fields, violation.Field)
+	}
+
+	return fields
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/baizhigit/go-ms-examples/di/ufo/internal/model"
+)
+
+func TestTruncate(t *testing.T) {
+	// Понедельник, 03:00 по UTC+5 - это еще воскресенье 22:00 по UTC
+	observedAt := time.Date(2024, 6, 17, 3, 0, 0, 0, time.FixedZone("UTC+5", 5*60*60))
+
+	require.Equal(t, time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC), Truncate(observedAt, model.StatsGroupByDay))
+	require.Equal(t, time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), Truncate(observedAt, model.StatsGroupByWeek))
+	require.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Truncate(observedAt, model.StatsGroupByMonth))
+}
+
+func TestTruncateWeekAcrossMonth(t *testing.T) {
+	// 1 мая 2024 - среда, неделя начинается в апреле
+	require.Equal(t,
+		time.Date(2024, 4, 29, 0, 0, 0, 0, time.UTC),
+		Truncate(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), model.StatsGroupByWeek),
+	)
+}
+
+func TestNextAndKey(t *testing.T) {
+	start := time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, "2024-12-30", Key(start, model.StatsGroupByDay))
+	require.Equal(t, "2024-12-31", Key(Next(start, model.StatsGroupByDay), model.StatsGroupByDay))
+	// Неделя с 30 декабря 2024 по ISO 8601 первая неделя 2025 года
+	require.Equal(t, "2025-W01", Key(start, model.StatsGroupByWeek))
+	require.Equal(t, "2025-W02", Key(Next(start, model.StatsGroupByWeek), model.StatsGroupByWeek))
+
+	month := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, "2025-01", Key(Next(month, model.StatsGroupByMonth), model.StatsGroupByMonth))
+}
+
+func TestSoundKey(t *testing.T) {
+	yes, no := true, false
+
+	require.Equal(t, "true", SoundKey(&yes))
+	require.Equal(t, "false", SoundKey(&no))
+	require.Empty(t, SoundKey(nil))
+}
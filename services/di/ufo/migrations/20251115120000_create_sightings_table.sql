@@ -0,0 +1,23 @@
+-- +goose Up
+create table sightings (
+    uuid uuid primary key,
+    observed_at timestamptz,
+    location text not null,
+    description text not null,
+    color text,
+    sound boolean,
+    duration_seconds integer,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    version bigint not null default 1
+);
+
+-- Индекс под сортировку и курсорную пагинацию в List
+create index sightings_observed_at_uuid_idx on sightings (observed_at desc nulls last, uuid desc);
+
+-- Индекс под окончательное удаление в Purge
+create index sightings_deleted_at_idx on sightings (deleted_at) where deleted_at is not null;
+
+-- +goose Down
+drop table sightings;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Вектор для полнотекстового поиска в Search. Конфигурация simple не делает стемминга,
+-- поэтому найденные слова совпадают с подсветкой в сервисе; место наблюдения весит больше описания
+alter table sightings
+    add column search_vector tsvector generated always as (
+        setweight(to_tsvector('simple', location), 'A') ||
+        setweight(to_tsvector('simple', description), 'B')
+    ) stored;
+
+create index sightings_search_vector_idx on sightings using gin (search_vector);
+
+-- +goose Down
+drop index sightings_search_vector_idx;
+
+alter table sightings drop column search_vector;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Координаты места наблюдения: x - долгота, y - широта
+alter table sightings add column coordinates point;
+
+-- Индекс под отсечение по широте в FindNearby, расстояние затем считается по формуле гаверсинусов
+create index sightings_latitude_idx on sightings ((coordinates[1])) where coordinates is not null;
+
+-- +goose Down
+drop index sightings_latitude_idx;
+
+alter table sightings drop column coordinates;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Метаданные вложений в порядке загрузки, содержимое хранится вне базы
+alter table sightings add column attachments jsonb not null default '[]';
+
+-- +goose Down
+alter table sightings drop column attachments;
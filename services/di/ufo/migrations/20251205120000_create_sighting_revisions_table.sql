@@ -0,0 +1,16 @@
+-- +goose Up
+-- Неизменяемая история изменений наблюдений, одна ревизия на версию.
+-- Внешнего ключа на sightings нет: история остается после окончательного удаления наблюдения
+create table sighting_revisions (
+    sighting_uuid uuid not null,
+    version bigint not null,
+    action text not null,
+    actor text not null default '',
+    occurred_at timestamptz not null,
+    changes jsonb not null default '[]',
+    sighting jsonb not null,
+    primary key (sighting_uuid, version)
+);
+
+-- +goose Down
+drop table sighting_revisions;
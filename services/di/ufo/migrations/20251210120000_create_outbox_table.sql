@@ -0,0 +1,19 @@
+-- +goose Up
+-- События об изменениях наблюдений, записанные в транзакции изменения и ожидающие публикации.
+-- id - UUIDv7, поэтому сортировка по id совпадает с порядком записи
+create table outbox (
+    id uuid primary key,
+    type text not null,
+    sighting_uuid uuid not null,
+    version bigint not null,
+    payload jsonb not null,
+    occurred_at timestamptz not null,
+    attempts integer not null default 0,
+    next_attempt_at timestamptz not null,
+    last_error text not null default ''
+);
+
+create index outbox_next_attempt_at_idx on outbox (next_attempt_at);
+
+-- +goose Down
+drop table outbox;
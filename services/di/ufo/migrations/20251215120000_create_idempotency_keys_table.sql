@@ -0,0 +1,12 @@
+-- +goose Up
+-- Ключи идемпотентности Create: ключ -> наблюдение, созданное первым запросом с ним.
+-- Истекшие ключи перезаписываются при повторном использовании и удаляются в Purge
+create table idempotency_keys (
+    key text primary key,
+    request_hash text not null,
+    uuid uuid not null,
+    expires_at timestamptz not null
+);
+
+-- +goose Down
+drop table idempotency_keys;
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/grpc/pkg/proto/ufo/v1"
+)
+
+// importProgressEvery через сколько отправленных наблюдений печатается прогресс
+const importProgressEvery = 10_000
+
+// runImport загружает наблюдения через клиентский стрим ImportSightings.
+// Источник - NDJSON файл, где каждая строка это SightingInfo в формате protojson,
+// либо сгенерированные gofakeit данные.
+func runImport(args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	address := flags.String("addr", serverAddress, "адрес gRPC сервера")
+	file := flags.String("file", "", "NDJSON файл с наблюдениями, - для чтения из stdin")
+	fake := flags.Int("fake", 0, "количество сгенерированных наблюдений, если файл не указан")
+	_ = flags.Parse(args)
+
+	if *file == "" && *fake <= 0 {
+		log.Println("укажите -file с архивом наблюдений или -fake с количеством наблюдений")
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	// Прерывание по Ctrl+C отменяет стрим, уже сохраненные наблюдения остаются на сервере
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	client, closeConn, err := newClient(*address)
+	if err != nil {
+		log.Printf("failed to connect: %v\n", err)
+		return
+	}
+	defer closeConn()
+
+	stream, err := client.ImportSightings(ctx)
+	if err != nil {
+		log.Printf("Ошибка при открытии стрима импорта: %v\n", err)
+		return
+	}
+
+	if *file != "" {
+		err = sendFile(*file, stream.Send)
+	} else {
+		err = sendFake(*fake, stream.Send)
+	}
+	// io.EOF от Send означает, что сервер завершил стрим, причину вернет CloseAndRecv
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.Printf("Ошибка при отправке наблюдений: %v\n", err)
+		return
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Printf("Ошибка импорта: %v\n", err)
+		return
+	}
+
+	log.Println("📦 Импорт завершен")
+	log.Println("=================")
+	log.Printf("Принято: %d, сохранено: %d, отклонено: %d, длительность: %s\n",
+		resp.GetReceivedCount(), resp.GetInsertedCount(), resp.GetRejectedCount(), resp.GetDuration().AsDuration())
+
+	for _, rejection := range resp.GetRejections() {
+		log.Printf("  #%d: %s\n", rejection.GetIndex(), rejection.GetReason())
+	}
+	if omitted := resp.GetRejectedCount() - int64(len(resp.GetRejections())); omitted > 0 {
+		log.Printf("  ... и еще %d отказов\n", omitted)
+	}
+}
+
+// sendFile построчно читает NDJSON архив и отправляет каждую строку отдельным сообщением.
+// Нечитаемая строка отправляется пустым сообщением, чтобы сервер отклонил ее
+// и номер отказа совпал с номером строки.
+func sendFile(path string, send func(*ufoV1.ImportSightingsRequest) error) error {
+	input := os.Stdin
+	if path != "-" {
+		f, err := os.Open(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := f.Close(); cerr != nil {
+				log.Printf("failed to close file: %v", cerr)
+			}
+		}()
+		input = f
+	}
+
+	reader := bufio.NewReader(input)
+	for index := 0; ; index++ {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) && len(line) == 0 {
+			return nil
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		req := &ufoV1.ImportSightingsRequest{}
+		info := &ufoV1.SightingInfo{}
+		perr := protojson.Unmarshal(bytes.TrimSpace(line), info)
+		if perr != nil {
+			log.Printf("Строка %d пропущена: %v\n", index+1, perr)
+		} else {
+			req.Info = info
+		}
+
+		serr := send(req)
+		if serr != nil {
+			return serr
+		}
+		reportProgress(index + 1)
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// sendFake отправляет count сгенерированных наблюдений
+func sendFake(count int, send func(*ufoV1.ImportSightingsRequest) error) error {
+	for i := 0; i < count; i++ {
+		err := send(&ufoV1.ImportSightingsRequest{Info: fakeSightingInfo()})
+		if err != nil {
+			return err
+		}
+		reportProgress(i + 1)
+	}
+
+	return nil
+}
+
+func reportProgress(sent int) {
+	if sent%importProgressEvery == 0 {
+		log.Printf("Отправлено наблюдений: %d\n", sent)
+	}
+}
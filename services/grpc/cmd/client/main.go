@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -16,8 +17,8 @@ import (
 
 const serverAddress = "localhost:50051"
 
-// createSighting создает новое наблюдение НЛО с рандомными данными
-func createSighting(ctx context.Context, client ufoV1.UFOServiceClient) (string, error) {
+// fakeSightingInfo генерирует информацию о наблюдении НЛО с рандомными данными
+func fakeSightingInfo() *ufoV1.SightingInfo {
 	// Генерируем случайные данные с помощью gofakeit
 	observedAt := gofakeit.DateRange(
 		time.Now().AddDate(-3, 0, 0), // за последние 3 года
@@ -46,8 +47,13 @@ func createSighting(ctx context.Context, client ufoV1.UFOServiceClient) (string,
 		info.DurationSeconds = wrapperspb.Int32(gofakeit.Int32())
 	}
 
+	return info
+}
+
+// createSighting создает новое наблюдение НЛО с рандомными данными
+func createSighting(ctx context.Context, client ufoV1.UFOServiceClient) (string, error) {
 	// Вызываем gRPC метод Create
-	resp, err := client.Create(ctx, &ufoV1.CreateRequest{Info: info})
+	resp, err := client.Create(ctx, &ufoV1.CreateRequest{Info: fakeSightingInfo()})
 	if err != nil {
 		return "", err
 	}
@@ -123,24 +129,45 @@ func deleteSighting(ctx context.Context, client ufoV1.UFOServiceClient, uuid str
 }
 
 func main() {
-	ctx := context.Background()
+	// Подкоманда import загружает архив наблюдений, без подкоманды запускается демонстрация API
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		runImport(os.Args[2:])
+		return
+	}
 
+	runDemo()
+}
+
+// newClient подключается к серверу и возвращает gRPC клиент вместе с функцией закрытия соединения
+func newClient(address string) (ufoV1.UFOServiceClient, func(), error) {
 	conn, err := grpc.NewClient(
-		serverAddress,
+		address,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
-		log.Printf("failed to connect: %v\n", err)
-		return
+		return nil, nil, err
 	}
-	defer func() {
+
+	closeConn := func() {
 		if cerr := conn.Close(); cerr != nil {
 			log.Printf("failed to close connect: %v", cerr)
 		}
-	}()
+	}
+
+	return ufoV1.NewUFOServiceClient(conn), closeConn, nil
+}
+
+// runDemo последовательно вызывает методы API для одного наблюдения
+func runDemo() {
+	ctx := context.Background()
 
 	// Создаем gRPC клиент
-	client := ufoV1.NewUFOServiceClient(conn)
+	client, closeConn, err := newClient(serverAddress)
+	if err != nil {
+		log.Printf("failed to connect: %v\n", err)
+		return
+	}
+	defer closeConn()
 
 	log.Println("=== Тестирование API для работы с наблюдениями НЛО ===")
 	log.Println()
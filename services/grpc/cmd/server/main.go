@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"os"
@@ -16,6 +18,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -24,6 +27,13 @@ import (
 
 const grpcPort = 50051
 
+const (
+	// importBatchSize сколько наблюдений ImportSightings сохраняет за одну блокировку
+	importBatchSize = 100
+	// maxImportRejections сколько отказов с причинами попадает в ответ ImportSightings
+	maxImportRejections = 1000
+)
+
 // ufoService реализует gRPC сервис для работы с наблюдениями НЛО
 type ufoService struct {
 	ufoV1.UnimplementedUFOServiceServer
@@ -130,6 +140,73 @@ func (s *ufoService) Delete(_ context.Context, req *ufoV1.DeleteRequest) (*empty
 	return &emptypb.Empty{}, nil
 }
 
+// ImportSightings принимает поток наблюдений НЛО и сохраняет их пакетами
+func (s *ufoService) ImportSightings(stream ufoV1.UFOService_ImportSightingsServer) error {
+	start := time.Now()
+	resp := &ufoV1.ImportSightingsResponse{}
+
+	reject := func(index int64, reason string) {
+		resp.RejectedCount++
+		if len(resp.Rejections) < maxImportRejections {
+			resp.Rejections = append(resp.Rejections, &ufoV1.ImportRejection{
+				Index:  index,
+				Reason: reason,
+			})
+		}
+	}
+
+	batch := make([]*ufoV1.SightingInfo, 0, importBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for _, info := range batch {
+			newUUID := uuid.NewString()
+			s.sightings[newUUID] = &ufoV1.Sighting{
+				Uuid:      newUUID,
+				Info:      info,
+				CreatedAt: timestamppb.New(time.Now()),
+			}
+		}
+
+		resp.InsertedCount += int64(len(batch))
+		batch = batch[:0]
+	}
+
+	for index := int64(0); ; index++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		resp.ReceivedCount++
+
+		if req.GetInfo() == nil {
+			reject(index, "info is empty")
+			continue
+		}
+
+		batch = append(batch, req.GetInfo())
+		if len(batch) == importBatchSize {
+			flush()
+		}
+	}
+	flush()
+
+	resp.Duration = durationpb.New(time.Since(start))
+
+	log.Printf("Импортировано наблюдений: %d, отклонено: %d", resp.InsertedCount, resp.RejectedCount)
+
+	return stream.SendAndClose(resp)
+}
+
 func main() {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
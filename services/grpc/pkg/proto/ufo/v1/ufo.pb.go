@@ -11,6 +11,7 @@ package ufov1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
@@ -575,11 +576,194 @@ func (x *DeleteRequest) GetUuid() string {
 	return ""
 }
 
+// ImportSightingsRequest одно наблюдение из импортируемого потока
+type ImportSightingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// info данные импортируемого наблюдения
+	Info          *SightingInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSightingsRequest) Reset() {
+	*x = ImportSightingsRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSightingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSightingsRequest) ProtoMessage() {}
+
+func (x *ImportSightingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSightingsRequest.ProtoReflect.Descriptor instead.
+func (*ImportSightingsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportSightingsRequest) GetInfo() *SightingInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// ImportRejection наблюдение из потока, которое не удалось сохранить
+type ImportRejection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index порядковый номер сообщения в потоке, начиная с 0
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// reason причина отказа
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRejection) Reset() {
+	*x = ImportRejection{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRejection) ProtoMessage() {}
+
+func (x *ImportRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRejection.ProtoReflect.Descriptor instead.
+func (*ImportRejection) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportRejection) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportRejection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ImportSightingsResponse итог импорта
+type ImportSightingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// received_count количество принятых сообщений
+	ReceivedCount int64 `protobuf:"varint,1,opt,name=received_count,json=receivedCount,proto3" json:"received_count,omitempty"`
+	// inserted_count количество сохраненных наблюдений
+	InsertedCount int64 `protobuf:"varint,2,opt,name=inserted_count,json=insertedCount,proto3" json:"inserted_count,omitempty"`
+	// rejected_count количество отклоненных наблюдений
+	RejectedCount int64 `protobuf:"varint,3,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	// rejections причины отказов (не больше первой тысячи, полное количество в rejected_count)
+	Rejections []*ImportRejection `protobuf:"bytes,4,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	// duration длительность импорта на стороне сервера
+	Duration      *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSightingsResponse) Reset() {
+	*x = ImportSightingsResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSightingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSightingsResponse) ProtoMessage() {}
+
+func (x *ImportSightingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSightingsResponse.ProtoReflect.Descriptor instead.
+func (*ImportSightingsResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportSightingsResponse) GetReceivedCount() int64 {
+	if x != nil {
+		return x.ReceivedCount
+	}
+	return 0
+}
+
+func (x *ImportSightingsResponse) GetInsertedCount() int64 {
+	if x != nil {
+		return x.InsertedCount
+	}
+	return 0
+}
+
+func (x *ImportSightingsResponse) GetRejectedCount() int64 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *ImportSightingsResponse) GetRejections() []*ImportRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *ImportSightingsResponse) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
 var File_ufo_v1_ufo_proto protoreflect.FileDescriptor
 
 const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\n" +
-	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xb7\x02\n" +
+	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/duration.proto\"\xb7\x02\n" +
 	"\fSightingInfo\x12;\n" +
 	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"observedAt\x12\x1a\n" +
@@ -619,13 +803,27 @@ const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\vupdate_info\x18\x02 \x01(\v2\x1a.ufo.v1.SightingUpdateInfoR\n" +
 	"updateInfo\"#\n" +
 	"\rDeleteRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid2\xe7\x01\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"B\n" +
+	"\x16ImportSightingsRequest\x12(\n" +
+	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\"?\n" +
+	"\x0fImportRejection\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xfe\x01\n" +
+	"\x17ImportSightingsResponse\x12%\n" +
+	"\x0ereceived_count\x18\x01 \x01(\x03R\rreceivedCount\x12%\n" +
+	"\x0einserted_count\x18\x02 \x01(\x03R\rinsertedCount\x12%\n" +
+	"\x0erejected_count\x18\x03 \x01(\x03R\rrejectedCount\x127\n" +
+	"\n" +
+	"rejections\x18\x04 \x03(\v2\x17.ufo.v1.ImportRejectionR\n" +
+	"rejections\x125\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bduration2\xbd\x02\n" +
 	"\n" +
 	"UFOService\x127\n" +
 	"\x06Create\x12\x15.ufo.v1.CreateRequest\x1a\x16.ufo.v1.CreateResponse\x12.\n" +
 	"\x03Get\x12\x12.ufo.v1.GetRequest\x1a\x13.ufo.v1.GetResponse\x127\n" +
 	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\x127\n" +
-	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.EmptyBAZ?github.com/baizhigit/go-ms-examples/grpc/pkg/proto/ufo/v1;ufov1b\x06proto3"
+	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x0fImportSightings\x12\x1e.ufo.v1.ImportSightingsRequest\x1a\x1f.ufo.v1.ImportSightingsResponse(\x01BAZ?github.com/baizhigit/go-ms-examples/grpc/pkg/proto/ufo/v1;ufov1b\x06proto3"
 
 var (
 	file_ufo_v1_ufo_proto_rawDescOnce sync.Once
@@ -639,54 +837,63 @@ func file_ufo_v1_ufo_proto_rawDescGZIP() []byte {
 	return file_ufo_v1_ufo_proto_rawDescData
 }
 
-var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_ufo_v1_ufo_proto_goTypes = []any{
-	(*SightingInfo)(nil),           // 0: ufo.v1.SightingInfo
-	(*SightingUpdateInfo)(nil),     // 1: ufo.v1.SightingUpdateInfo
-	(*Sighting)(nil),               // 2: ufo.v1.Sighting
-	(*CreateRequest)(nil),          // 3: ufo.v1.CreateRequest
-	(*CreateResponse)(nil),         // 4: ufo.v1.CreateResponse
-	(*GetRequest)(nil),             // 5: ufo.v1.GetRequest
-	(*GetResponse)(nil),            // 6: ufo.v1.GetResponse
-	(*UpdateRequest)(nil),          // 7: ufo.v1.UpdateRequest
-	(*DeleteRequest)(nil),          // 8: ufo.v1.DeleteRequest
-	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil), // 10: google.protobuf.StringValue
-	(*wrapperspb.BoolValue)(nil),   // 11: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),  // 12: google.protobuf.Int32Value
-	(*emptypb.Empty)(nil),          // 13: google.protobuf.Empty
+	(*SightingInfo)(nil),            // 0: ufo.v1.SightingInfo
+	(*SightingUpdateInfo)(nil),      // 1: ufo.v1.SightingUpdateInfo
+	(*Sighting)(nil),                // 2: ufo.v1.Sighting
+	(*CreateRequest)(nil),           // 3: ufo.v1.CreateRequest
+	(*CreateResponse)(nil),          // 4: ufo.v1.CreateResponse
+	(*GetRequest)(nil),              // 5: ufo.v1.GetRequest
+	(*GetResponse)(nil),             // 6: ufo.v1.GetResponse
+	(*UpdateRequest)(nil),           // 7: ufo.v1.UpdateRequest
+	(*DeleteRequest)(nil),           // 8: ufo.v1.DeleteRequest
+	(*ImportSightingsRequest)(nil),  // 9: ufo.v1.ImportSightingsRequest
+	(*ImportRejection)(nil),         // 10: ufo.v1.ImportRejection
+	(*ImportSightingsResponse)(nil), // 11: ufo.v1.ImportSightingsResponse
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),  // 13: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),    // 14: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),   // 15: google.protobuf.Int32Value
+	(*durationpb.Duration)(nil),     // 16: google.protobuf.Duration
+	(*emptypb.Empty)(nil),           // 17: google.protobuf.Empty
 }
 var file_ufo_v1_ufo_proto_depIdxs = []int32{
-	9,  // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
-	10, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
-	11, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
-	12, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
-	9,  // 4: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
-	10, // 5: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
-	10, // 6: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
-	10, // 7: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
-	11, // 8: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
-	12, // 9: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	12, // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
+	13, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
+	14, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
+	15, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	12, // 4: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
+	13, // 5: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
+	13, // 6: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
+	13, // 7: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
+	14, // 8: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
+	15, // 9: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
 	0,  // 10: ufo.v1.Sighting.info:type_name -> ufo.v1.SightingInfo
-	9,  // 11: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 12: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 13: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
+	12, // 11: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
+	12, // 12: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 13: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
 	0,  // 14: ufo.v1.CreateRequest.info:type_name -> ufo.v1.SightingInfo
 	2,  // 15: ufo.v1.GetResponse.sighting:type_name -> ufo.v1.Sighting
 	1,  // 16: ufo.v1.UpdateRequest.update_info:type_name -> ufo.v1.SightingUpdateInfo
-	3,  // 17: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
-	5,  // 18: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
-	7,  // 19: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
-	8,  // 20: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
-	4,  // 21: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
-	6,  // 22: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
-	13, // 23: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
-	13, // 24: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
-	21, // [21:25] is the sub-list for method output_type
-	17, // [17:21] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	0,  // 17: ufo.v1.ImportSightingsRequest.info:type_name -> ufo.v1.SightingInfo
+	10, // 18: ufo.v1.ImportSightingsResponse.rejections:type_name -> ufo.v1.ImportRejection
+	16, // 19: ufo.v1.ImportSightingsResponse.duration:type_name -> google.protobuf.Duration
+	3,  // 20: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
+	5,  // 21: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
+	7,  // 22: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
+	8,  // 23: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
+	9,  // 24: ufo.v1.UFOService.ImportSightings:input_type -> ufo.v1.ImportSightingsRequest
+	4,  // 25: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
+	6,  // 26: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
+	17, // 27: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
+	17, // 28: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
+	11, // 29: ufo.v1.UFOService.ImportSightings:output_type -> ufo.v1.ImportSightingsResponse
+	25, // [25:30] is the sub-list for method output_type
+	20, // [20:25] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_ufo_v1_ufo_proto_init() }
@@ -700,7 +907,7 @@ func file_ufo_v1_ufo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ufo_v1_ufo_proto_rawDesc), len(file_ufo_v1_ufo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,10 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UFOService_Create_FullMethodName = "/ufo.v1.UFOService/Create"
-	UFOService_Get_FullMethodName    = "/ufo.v1.UFOService/Get"
-	UFOService_Update_FullMethodName = "/ufo.v1.UFOService/Update"
-	UFOService_Delete_FullMethodName = "/ufo.v1.UFOService/Delete"
+	UFOService_Create_FullMethodName          = "/ufo.v1.UFOService/Create"
+	UFOService_Get_FullMethodName             = "/ufo.v1.UFOService/Get"
+	UFOService_Update_FullMethodName          = "/ufo.v1.UFOService/Update"
+	UFOService_Delete_FullMethodName          = "/ufo.v1.UFOService/Delete"
+	UFOService_ImportSightings_FullMethodName = "/ufo.v1.UFOService/ImportSightings"
 )
 
 // UFOServiceClient is the client API for UFOService service.
@@ -42,6 +43,8 @@ type UFOServiceClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ImportSightings принимает поток наблюдений НЛО, сохраняет их пакетами и возвращает итог импорта
+	ImportSightings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportSightingsRequest, ImportSightingsResponse], error)
 }
 
 type uFOServiceClient struct {
@@ -92,6 +95,19 @@ func (c *uFOServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts .
 	return out, nil
 }
 
+func (c *uFOServiceClient) ImportSightings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportSightingsRequest, ImportSightingsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[0], UFOService_ImportSightings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportSightingsRequest, ImportSightingsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_ImportSightingsClient = grpc.ClientStreamingClient[ImportSightingsRequest, ImportSightingsResponse]
+
 // UFOServiceServer is the server API for UFOService service.
 // All implementations must embed UnimplementedUFOServiceServer
 // for forward compatibility.
@@ -106,6 +122,8 @@ type UFOServiceServer interface {
 	Update(context.Context, *UpdateRequest) (*emptypb.Empty, error)
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// ImportSightings принимает поток наблюдений НЛО, сохраняет их пакетами и возвращает итог импорта
+	ImportSightings(grpc.ClientStreamingServer[ImportSightingsRequest, ImportSightingsResponse]) error
 	mustEmbedUnimplementedUFOServiceServer()
 }
 
@@ -128,6 +146,9 @@ func (UnimplementedUFOServiceServer) Update(context.Context, *UpdateRequest) (*e
 func (UnimplementedUFOServiceServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUFOServiceServer) ImportSightings(grpc.ClientStreamingServer[ImportSightingsRequest, ImportSightingsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSightings not implemented")
+}
 func (UnimplementedUFOServiceServer) mustEmbedUnimplementedUFOServiceServer() {}
 func (UnimplementedUFOServiceServer) testEmbeddedByValue()                    {}
 
@@ -221,6 +242,13 @@ func _UFOService_Delete_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UFOService_ImportSightings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UFOServiceServer).ImportSightings(&grpc.GenericServerStream[ImportSightingsRequest, ImportSightingsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_ImportSightingsServer = grpc.ClientStreamingServer[ImportSightingsRequest, ImportSightingsResponse]
+
 // UFOService_ServiceDesc is the grpc.ServiceDesc for UFOService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -245,6 +273,12 @@ var UFOService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _UFOService_Delete_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportSightings",
+			Handler:       _UFOService_ImportSightings_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "ufo/v1/ufo.proto",
 }
@@ -15,6 +15,9 @@ import (
 
 const serverAddress = "localhost:50051"
 
+// colors цвета, которые принимает сервер (правило validate.rules у поля color)
+var colors = []string{"белый", "красный", "оранжевый", "зеленый", "голубой", "серебристый"}
+
 // createSighting создает новое наблюдение НЛО с рандомными данными
 func createSighting(ctx context.Context, client ufoV1.UFOServiceClient) (string, error) {
 	// Генерируем случайные данные с помощью gofakeit
@@ -34,7 +37,7 @@ func createSighting(ctx context.Context, client ufoV1.UFOServiceClient) (string,
 
 	// Иногда добавляем дополнительные поля (с вероятностью 70%)
 	if gofakeit.Bool() {
-		info.Color = wrapperspb.String(gofakeit.Color())
+		info.Color = wrapperspb.String(gofakeit.RandomString(colors))
 	}
 
 	if gofakeit.Bool() {
@@ -42,7 +45,7 @@ func createSighting(ctx context.Context, client ufoV1.UFOServiceClient) (string,
 	}
 
 	if gofakeit.Bool() {
-		info.DurationSeconds = wrapperspb.Int32(gofakeit.Int32())
+		info.DurationSeconds = wrapperspb.Int32(int32(gofakeit.Number(1, 600)))
 	}
 
 	// Вызываем gRPC метод Create
@@ -88,7 +91,7 @@ func updateSighting(ctx context.Context, client ufoV1.UFOServiceClient, uuid str
 	}
 
 	if gofakeit.Bool() {
-		updateInfo.Color = wrapperspb.String(gofakeit.Color())
+		updateInfo.Color = wrapperspb.String(gofakeit.RandomString(colors))
 	}
 
 	if gofakeit.Bool() {
@@ -96,7 +99,7 @@ func updateSighting(ctx context.Context, client ufoV1.UFOServiceClient, uuid str
 	}
 
 	if gofakeit.Bool() {
-		updateInfo.DurationSeconds = wrapperspb.Int32(gofakeit.Int32())
+		updateInfo.DurationSeconds = wrapperspb.Int32(int32(gofakeit.Number(1, 600)))
 	}
 
 	// Вызываем gRPC метод Update
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/grpc_gateway/pkg/proto/ufo/v1"
+)
+
+const (
+	exportPath = "/api/v1/ufo/export"
+
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+
+	// exportFlushEvery через сколько строк выгрузка сбрасывается клиенту
+	exportFlushEvery = 100
+)
+
+// exportColumns заголовок CSV выгрузки
+var exportColumns = []string{
+	"uuid",
+	"observed_at",
+	"location",
+	"description",
+	"color",
+	"sound",
+	"duration_seconds",
+	"created_at",
+	"updated_at",
+	"deleted_at",
+	"version",
+}
+
+// exportQueryFilter параметры запроса выгрузки, которые не относятся к ExportRequest
+var exportQueryFilter = utilities.NewDoubleArray([][]string{{"format"}})
+
+// exportHandler отдает результат Export в CSV или NDJSON по мере получения из gRPC стрима,
+// не накапливая выгрузку в памяти. Фильтры передаются так же, как в запросах gRPC Gateway:
+// filter.location=..., filter.observed_from=2024-01-01T00:00:00Z и т.д.
+func exportHandler(mux *runtime.ServeMux, client ufoV1.UFOServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx := r.Context()
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		query := r.URL.Query()
+		format := query.Get("format")
+		if format == "" {
+			format = exportFormatCSV
+		}
+		if format != exportFormatCSV && format != exportFormatNDJSON {
+			runtime.HTTPError(ctx, mux, marshaler, w, r,
+				status.Errorf(codes.InvalidArgument, "unsupported export format %q, use csv or ndjson", format))
+			return
+		}
+
+		req := &ufoV1.ExportRequest{}
+		err := runtime.PopulateQueryParameters(req, query, exportQueryFilter)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		stream, err := client.Export(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		// Ошибку сервера можно вернуть статусом, только пока ответ не начат,
+		// поэтому первое наблюдение читаем до записи заголовков
+		first, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="sightings.%s"`, format))
+
+		var write func(*ufoV1.Sighting) error
+		var flush func() error
+		switch format {
+		case exportFormatCSV:
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			csvWriter := csv.NewWriter(w)
+			err = csvWriter.Write(exportColumns)
+			if err != nil {
+				abortExport(err)
+			}
+			write = func(sighting *ufoV1.Sighting) error {
+				return csvWriter.Write(sightingToCSVRecord(sighting))
+			}
+			flush = func() error {
+				csvWriter.Flush()
+				return csvWriter.Error()
+			}
+		case exportFormatNDJSON:
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			write = func(sighting *ufoV1.Sighting) error {
+				line, merr := marshaler.Marshal(sighting)
+				if merr != nil {
+					return merr
+				}
+				_, werr := w.Write(append(line, '\n'))
+				return werr
+			}
+			flush = func() error { return nil }
+		}
+
+		for rows := 1; first != nil; rows++ {
+			err = write(first)
+			if err != nil {
+				abortExport(err)
+			}
+
+			if rows%exportFlushEvery == 0 {
+				flushExport(w, flush)
+			}
+
+			first, err = stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				abortExport(err)
+			}
+		}
+
+		flushExport(w, flush)
+	}
+}
+
+// flushExport сбрасывает буфер формата и отправляет накопленные данные клиенту
+func flushExport(w http.ResponseWriter, flush func() error) {
+	err := flush()
+	if err != nil {
+		abortExport(err)
+	}
+
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// abortExport обрывает соединение посреди выгрузки: статус уже отправлен,
+// и только так клиент узнает, что получил неполные данные
+func abortExport(err error) {
+	log.Printf("export aborted: %v\n", err)
+	panic(http.ErrAbortHandler)
+}
+
+// sightingToCSVRecord преобразует наблюдение в строку CSV в порядке exportColumns
+func sightingToCSVRecord(sighting *ufoV1.Sighting) []string {
+	info := sighting.GetInfo()
+
+	record := []string{
+		sighting.GetUuid(),
+		formatExportTime(info.GetObservedAt()),
+		csvText(info.GetLocation()),
+		csvText(info.GetDescription()),
+		"",
+		"",
+		"",
+		formatExportTime(sighting.GetCreatedAt()),
+		formatExportTime(sighting.GetUpdatedAt()),
+		formatExportTime(sighting.GetDeletedAt()),
+		strconv.FormatInt(sighting.GetVersion(), 10),
+	}
+
+	if info.GetColor() != nil {
+		record[4] = csvText(info.GetColor().GetValue())
+	}
+	if info.GetSound() != nil {
+		record[5] = strconv.FormatBool(info.GetSound().GetValue())
+	}
+	if info.GetDurationSeconds() != nil {
+		record[6] = strconv.FormatInt(int64(info.GetDurationSeconds().GetValue()), 10)
+	}
+
+	return record
+}
+
+// csvText защищает от formula injection текст, введенный пользователем: электронные таблицы
+// выполняют ячейку, которая начинается с =, +, -, @, табуляции или перевода каретки,
+// поэтому такой ячейке добавляется апостроф и она читается как текст
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+// formatExportTime форматирует время в RFC 3339 (UTC), пустая строка для отсутствующего значения
+func formatExportTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	return ts.AsTime().UTC().Format(time.RFC3339Nano)
+}
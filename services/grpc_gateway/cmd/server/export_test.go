@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	ufoV1 "github.com/baizhigit/go-ms-examples/grpc_gateway/pkg/proto/ufo/v1"
+)
+
+func TestSightingToCSVRecordNeutralisesFormulas(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "=HYPERLINK(\"http://evil\")", want: "'=HYPERLINK(\"http://evil\")"},
+		{value: "+1", want: "'+1"},
+		{value: "-1+2", want: "'-1+2"},
+		{value: "@SUM(A1)", want: "'@SUM(A1)"},
+		{value: "\t=1", want: "'\t=1"},
+		{value: "\r=1", want: "'\r=1"},
+		{value: "Москва = столица", want: "Москва = столица"},
+		{value: "", want: ""},
+	}
+	for _, tt := range tests {
+		record := sightingToCSVRecord(&ufoV1.Sighting{
+			Info: &ufoV1.SightingInfo{
+				Location:    tt.value,
+				Description: tt.value,
+				Color:       wrapperspb.String(tt.value),
+			},
+		})
+
+		for _, column := range []int{2, 3, 4} {
+			if record[column] != tt.want {
+				t.Errorf("%s for %q = %q, want %q", exportColumns[column], tt.value, record[column], tt.want)
+			}
+		}
+	}
+}
@@ -2,12 +2,17 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -18,35 +23,84 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
 	grpcPort = 50051
 	httpPort = 8081
+
+	// Ключи метаданных, через которые версия записи передается в HTTP заголовки ETag/If-Match и обратно
+	etagMetadataKey    = "etag"
+	ifMatchMetadataKey = "if-match"
+
+	// Ключ метаданных, в который пробрасывается HTTP заголовок Idempotency-Key
+	idempotencyKeyMetadataKey = "idempotency-key"
+	// Сколько хранится ключ идемпотентности
+	idempotencyKeyTTL = 24 * time.Hour
+	// Как часто Create удаляет истекшие ключи, к которым больше не обращаются
+	idempotencySweepInterval = time.Hour
 )
 
+// idempotentResult результат Create, сохраненный под ключом идемпотентности
+type idempotentResult struct {
+	requestHash string
+	uuid        string
+	expiresAt   time.Time
+}
+
 // ufoService реализует gRPC сервис для работы с наблюдениями НЛО
 type ufoService struct {
 	ufoV1.UnimplementedUFOServiceServer
 
-	mu        sync.RWMutex
-	sightings map[string]*ufoV1.Sighting
+	mu              sync.RWMutex
+	sightings       map[string]*ufoV1.Sighting
+	idempotencyKeys map[string]idempotentResult
+	lastSweep       time.Time
 }
 
-// Create создает новое наблюдение НЛО
-func (s *ufoService) Create(_ context.Context, req *ufoV1.CreateRequest) (*ufoV1.CreateResponse, error) {
-	// Выполняем валидацию запроса
-	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+// Create создает новое наблюдение НЛО.
+// Если передан ключ идемпотентности, повтор с теми же данными возвращает исходный UUID
+func (s *ufoService) Create(ctx context.Context, req *ufoV1.CreateRequest) (*ufoV1.CreateResponse, error) {
+	key := idempotencyKey(ctx)
+	requestHash, err := infoHash(req.GetInfo())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash request: %v", err)
 	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	now := time.Now()
+	s.sweepIdempotencyKeys(now)
+
+	if key != "" {
+		stored, ok := s.idempotencyKeys[key]
+		if ok && !now.Before(stored.expiresAt) {
+			delete(s.idempotencyKeys, key)
+			ok = false
+		}
+
+		if ok {
+			if stored.requestHash != requestHash {
+				return nil, status.Errorf(codes.FailedPrecondition, "idempotency key %q reused with a different request", key)
+			}
+
+			log.Printf("Повторный запрос с ключом %s, возвращаем наблюдение %s", key, stored.uuid)
+
+			return &ufoV1.CreateResponse{
+				Uuid: stored.uuid,
+			}, nil
+		}
+	}
+
 	// Генерируем UUID для нового наблюдения
 	newUUID := uuid.NewString()
 
@@ -54,10 +108,19 @@ func (s *ufoService) Create(_ context.Context, req *ufoV1.CreateRequest) (*ufoV1
 		Uuid:      newUUID,
 		Info:      req.GetInfo(),
 		CreatedAt: timestamppb.New(time.Now()),
+		Version:   1,
 	}
 
 	s.sightings[newUUID] = sighting
 
+	if key != "" {
+		s.idempotencyKeys[key] = idempotentResult{
+			requestHash: requestHash,
+			uuid:        newUUID,
+			expiresAt:   now.Add(idempotencyKeyTTL),
+		}
+	}
+
 	log.Printf("Создано наблюдение с UUID %s", newUUID)
 
 	return &ufoV1.CreateResponse{
@@ -65,8 +128,44 @@ func (s *ufoService) Create(_ context.Context, req *ufoV1.CreateRequest) (*ufoV1
 	}, nil
 }
 
+// sweepIdempotencyKeys раз в idempotencySweepInterval удаляет истекшие ключи, чтобы карта
+// не росла с каждым когда-либо переданным ключом. Вызывается под s.mu
+func (s *ufoService) sweepIdempotencyKeys(now time.Time) {
+	if now.Sub(s.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, stored := range s.idempotencyKeys {
+		if !now.Before(stored.expiresAt) {
+			delete(s.idempotencyKeys, key)
+		}
+	}
+}
+
+// idempotencyKey возвращает ключ идемпотентности из метаданных запроса, проброшенный из заголовка Idempotency-Key
+func idempotencyKey(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, idempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// infoHash отпечаток данных наблюдения для сравнения повторных запросов
+func infoHash(info *ufoV1.SightingInfo) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // Get возвращает наблюдение НЛО по UUID
-func (s *ufoService) Get(_ context.Context, req *ufoV1.GetRequest) (*ufoV1.GetResponse, error) {
+func (s *ufoService) Get(ctx context.Context, req *ufoV1.GetRequest) (*ufoV1.GetResponse, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -75,13 +174,20 @@ func (s *ufoService) Get(_ context.Context, req *ufoV1.GetRequest) (*ufoV1.GetRe
 		return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
 	}
 
+	setETag(ctx, sighting.GetVersion())
+
 	return &ufoV1.GetResponse{
 		Sighting: sighting,
 	}, nil
 }
 
 // Update обновляет существующее наблюдение НЛО
-func (s *ufoService) Update(_ context.Context, req *ufoV1.UpdateRequest) (*emptypb.Empty, error) {
+func (s *ufoService) Update(ctx context.Context, req *ufoV1.UpdateRequest) (*emptypb.Empty, error) {
+	expectedVersion, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -90,42 +196,81 @@ func (s *ufoService) Update(_ context.Context, req *ufoV1.UpdateRequest) (*empty
 		return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
 	}
 
-	if req.UpdateInfo == nil {
-		return nil, status.Error(codes.InvalidArgument, "update_info cannot be nil")
+	paths, err := updatePaths(req)
+	if err != nil {
+		return nil, err
 	}
 
-	// Обновляем поля, только если они были установлены в запросе
-	if req.GetUpdateInfo().ObservedAt != nil {
-		sighting.Info.ObservedAt = req.GetUpdateInfo().ObservedAt
+	if expectedVersion != nil && *expectedVersion != sighting.GetVersion() {
+		return nil, status.Errorf(codes.Aborted, "sighting with UUID %s was modified concurrently", req.GetUuid())
 	}
 
-	if req.GetUpdateInfo().Location != nil {
-		sighting.Info.Location = req.GetUpdateInfo().Location.Value
+	// Меняем только поля из маски: поле без значения в update_info очищается
+	updateInfo := req.GetUpdateInfo()
+	for _, path := range paths {
+		switch path {
+		case "observed_at":
+			sighting.Info.ObservedAt = updateInfo.GetObservedAt()
+		case "location":
+			sighting.Info.Location = updateInfo.GetLocation().GetValue()
+		case "description":
+			sighting.Info.Description = updateInfo.GetDescription().GetValue()
+		case "color":
+			sighting.Info.Color = updateInfo.GetColor()
+		case "sound":
+			sighting.Info.Sound = updateInfo.GetSound()
+		case "duration_seconds":
+			sighting.Info.DurationSeconds = updateInfo.GetDurationSeconds()
+		}
 	}
 
-	if req.GetUpdateInfo().Description != nil {
-		sighting.Info.Description = req.GetUpdateInfo().Description.Value
-	}
+	sighting.UpdatedAt = timestamppb.New(time.Now())
+	sighting.Version++
 
-	if req.GetUpdateInfo().Color != nil {
-		sighting.Info.Color = req.GetUpdateInfo().Color
-	}
+	setETag(ctx, sighting.GetVersion())
 
-	if req.GetUpdateInfo().Sound != nil {
-		sighting.Info.Sound = req.GetUpdateInfo().Sound
-	}
+	return &emptypb.Empty{}, nil
+}
+
+// updatePaths возвращает изменяемые поля SightingUpdateInfo: из update_mask, а если маски нет
+// (gRPC клиент ее не передал) - поля, заданные в update_info
+func updatePaths(req *ufoV1.UpdateRequest) ([]string, error) {
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		if req.UpdateInfo == nil {
+			return nil, status.Error(codes.InvalidArgument, "update_info cannot be nil")
+		}
 
-	if req.GetUpdateInfo().DurationSeconds != nil {
-		sighting.Info.DurationSeconds = req.GetUpdateInfo().DurationSeconds
+		var paths []string
+		req.GetUpdateInfo().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			paths = append(paths, string(fd.Name()))
+			return true
+		})
+		return paths, nil
 	}
 
-	sighting.UpdatedAt = timestamppb.New(time.Now())
+	// Обязательные location и description маска может только заменить, но не очистить
+	message := req.GetUpdateInfo().ProtoReflect()
+	fields := message.Descriptor().Fields()
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		field := fields.ByName(protoreflect.Name(path))
+		if field == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask path %q", path)
+		}
+		if (path == "location" || path == "description") && !message.Has(field) {
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask path %q cannot clear a required field", path)
+		}
+	}
 
-	return &emptypb.Empty{}, nil
+	return req.GetUpdateMask().GetPaths(), nil
 }
 
 // Delete удаляет наблюдение НЛО (мягкое удаление - устанавливает deleted_at)
-func (s *ufoService) Delete(_ context.Context, req *ufoV1.DeleteRequest) (*emptypb.Empty, error) {
+func (s *ufoService) Delete(ctx context.Context, req *ufoV1.DeleteRequest) (*emptypb.Empty, error) {
+	expectedVersion, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -134,12 +279,129 @@ func (s *ufoService) Delete(_ context.Context, req *ufoV1.DeleteRequest) (*empty
 		return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
 	}
 
+	if expectedVersion != nil && *expectedVersion != sighting.GetVersion() {
+		return nil, status.Errorf(codes.Aborted, "sighting with UUID %s was modified concurrently", req.GetUuid())
+	}
+
 	// Мягкое удаление - устанавливаем deleted_at
 	sighting.DeletedAt = timestamppb.New(time.Now())
+	sighting.Version++
 
 	return &emptypb.Empty{}, nil
 }
 
+// Export отправляет клиенту все наблюдения НЛО, подходящие под фильтр, по одному сообщению.
+// Наблюдения отдаются от новых к старым по времени создания.
+func (s *ufoService) Export(req *ufoV1.ExportRequest, stream ufoV1.UFOService_ExportServer) error {
+	filter := req.GetFilter()
+
+	// Под блокировкой собираем только указатели на подходящие наблюдения (8 байт на запись), чтобы
+	// отсортировать их: сами наблюдения не копируются в память заранее, а клонируются по одному
+	// непосредственно перед отправкой, поэтому выгрузка не буферизует данные результата
+	s.mu.RLock()
+	matched := make([]*ufoV1.Sighting, 0, len(s.sightings))
+	for _, sighting := range s.sightings {
+		if matchesFilter(sighting, filter) {
+			matched = append(matched, sighting)
+		}
+	}
+	s.mu.RUnlock()
+
+	slices.SortFunc(matched, func(a, b *ufoV1.Sighting) int {
+		if c := b.GetCreatedAt().AsTime().Compare(a.GetCreatedAt().AsTime()); c != 0 {
+			return c
+		}
+		return strings.Compare(b.GetUuid(), a.GetUuid())
+	})
+
+	for _, sighting := range matched {
+		// Update и Delete меняют наблюдение на месте, поэтому отправляем копию
+		s.mu.RLock()
+		snapshot, _ := proto.Clone(sighting).(*ufoV1.Sighting)
+		s.mu.RUnlock()
+
+		err := stream.Send(snapshot)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchesFilter проверяет, подходит ли наблюдение под фильтр выгрузки
+func matchesFilter(sighting *ufoV1.Sighting, filter *ufoV1.SightingFilter) bool {
+	if sighting.GetDeletedAt() != nil && !filter.GetIncludeDeleted() {
+		return false
+	}
+
+	info := sighting.GetInfo()
+
+	// Наблюдения без времени не попадают в выборку с ограничением по времени
+	if filter.GetObservedFrom() != nil || filter.GetObservedTo() != nil {
+		if info.GetObservedAt() == nil {
+			return false
+		}
+
+		observedAt := info.GetObservedAt().AsTime()
+		if filter.GetObservedFrom() != nil && observedAt.Before(filter.GetObservedFrom().AsTime()) {
+			return false
+		}
+		if filter.GetObservedTo() != nil && !observedAt.Before(filter.GetObservedTo().AsTime()) {
+			return false
+		}
+	}
+
+	if filter.GetLocation() != nil &&
+		!strings.Contains(strings.ToLower(info.GetLocation()), strings.ToLower(filter.GetLocation().GetValue())) {
+		return false
+	}
+
+	if filter.GetColor() != nil && (info.GetColor() == nil || info.GetColor().GetValue() != filter.GetColor().GetValue()) {
+		return false
+	}
+
+	if filter.GetSound() != nil && (info.GetSound() == nil || info.GetSound().GetValue() != filter.GetSound().GetValue()) {
+		return false
+	}
+
+	return true
+}
+
+// expectedVersion возвращает ожидаемую версию записи из запроса,
+// а если она не указана - из заголовка If-Match, проброшенного gRPC Gateway
+func expectedVersion(ctx context.Context, fromRequest *wrapperspb.Int64Value) (*int64, error) {
+	if fromRequest != nil {
+		version := fromRequest.GetValue()
+		return &version, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	values := md.Get(ifMatchMetadataKey)
+	if len(values) == 0 || values[0] == "*" {
+		return nil, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(values[0], `"`), 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid If-Match value %q", values[0])
+	}
+
+	return &version, nil
+}
+
+// setETag передает версию записи в заголовке ответа, который gRPC Gateway отдает как ETag
+func setETag(ctx context.Context, version int64) {
+	err := grpc.SetHeader(ctx, metadata.Pairs(etagMetadataKey, strconv.Quote(strconv.FormatInt(version, 10))))
+	if err != nil {
+		log.Printf("failed to set etag header: %v\n", err)
+	}
+}
+
 func main() {
 	// Запускаем gRPC сервер
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
@@ -153,12 +415,13 @@ func main() {
 		}
 	}()
 
-	// Создаем gRPC сервер
-	s := grpc.NewServer()
+	// Создаем gRPC сервер, запросы проверяются правилами protoc-gen-validate до обработчиков
+	s := grpc.NewServer(grpc.UnaryInterceptor(validationInterceptor))
 
 	// Регистрируем наш сервис
 	service := &ufoService{
-		sightings: make(map[string]*ufoV1.Sighting),
+		sightings:       make(map[string]*ufoV1.Sighting),
+		idempotencyKeys: make(map[string]idempotentResult),
 	}
 
 	ufoV1.RegisterUFOServiceServer(s, service)
@@ -183,8 +446,13 @@ func main() {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		// Создаем мультиплексор для HTTP запросов
-		mux := runtime.NewServeMux()
+		// Создаем мультиплексор для HTTP запросов.
+		// Версия записи пробрасывается как ETag в ответах и принимается из If-Match в запросах.
+		mux := runtime.NewServeMux(
+			runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+			runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+			runtime.WithErrorHandler(errorHandler),
+		)
 
 		// Настраиваем опции для соединения с gRPC сервером
 		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
@@ -201,14 +469,33 @@ func main() {
 			return
 		}
 
+		// Выгрузка в CSV/NDJSON не описывается через google.api.http, поэтому регистрируем
+		// обработчик на том же мультиплексоре: он вызывает Export через отдельного gRPC клиента
+		exportConn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", grpcPort), opts...)
+		if err != nil {
+			log.Printf("Failed to create export client: %v\n", err)
+			return
+		}
+		defer func() {
+			if cerr := exportConn.Close(); cerr != nil {
+				log.Printf("failed to close export client: %v\n", cerr)
+			}
+		}()
+
+		err = mux.HandlePath(http.MethodGet, exportPath, exportHandler(mux, ufoV1.NewUFOServiceClient(exportConn)))
+		if err != nil {
+			log.Printf("Failed to register export handler: %v\n", err)
+			return
+		}
+
 		// Создаем файловый сервер для swagger-ui
 		fileServer := http.FileServer(http.Dir("api"))
 
 		// Создаем HTTP маршрутизатор
 		httpMux := http.NewServeMux()
 
-		// Регистрируем API эндпоинты
-		httpMux.Handle("/api/", mux)
+		// Регистрируем API эндпоинты; прежний формат тела PATCH пока принимается
+		httpMux.Handle("/api/", legacyUpdateBody(mux))
 
 		// Swagger UI эндпоинты
 		httpMux.Handle("/swagger-ui.html", fileServer)
@@ -260,12 +547,58 @@ func main() {
 	log.Println("✅ gRPC server stopped")
 }
 
+// incomingHeaderMatcher пробрасывает If-Match и Idempotency-Key в метаданные gRPC запроса
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "If-Match") {
+		return ifMatchMetadataKey, true
+	}
+	if strings.EqualFold(key, "Idempotency-Key") {
+		return idempotencyKeyMetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher отдает версию записи из метаданных ответа как ETag
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == etagMetadataKey {
+		return "ETag", true
+	}
+
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
+// errorHandler возвращает ошибки валидации как application/problem+json,
+// 412 Precondition Failed вместо 409 Conflict, если версия не совпала с переданной в If-Match,
+// и 422 Unprocessable Entity, если Idempotency-Key уже использован с другими данными
+func errorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	if writeValidationProblem(w, err) {
+		return
+	}
+	if r.Header.Get("If-Match") != "" && status.Code(err) == codes.Aborted {
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusPreconditionFailed, Err: err}
+	}
+	if r.Header.Get("Idempotency-Key") != "" && status.Code(err) == codes.FailedPrecondition {
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusUnprocessableEntity, Err: err}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
 // CORS middleware для разрешения кросс-доменных запросов
 func cors(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PATCH, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, If-Match, Idempotency-Key")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const (
+	// updatePathPrefix путь PATCH /api/v1/ufo/{uuid}
+	updatePathPrefix = "/api/v1/ufo/"
+	// maxUpdateBodySize ограничивает тело, которое читается целиком для проверки формата
+	maxUpdateBodySize = 1 << 20
+)
+
+// legacyUpdateBody принимает прежний формат тела PATCH /api/v1/ufo/{uuid}
+// {"update_info": {...}, "expected_version": N}, пока клиенты переходят на новый, где тело -
+// сами поля update_info. update_info становится телом, а expected_version - параметром запроса,
+// поэтому маска строится из ключей update_info, как и для нового формата.
+// Формат устарел и будет удален
+func legacyUpdateBody(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || !strings.HasPrefix(r.URL.Path, updatePathPrefix) || r.Body == nil {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxUpdateBodySize+1))
+		if err != nil {
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxUpdateBodySize {
+			http.Error(w, "request body is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		updateInfo, query, ok := parseLegacyUpdateBody(body)
+		if ok {
+			log.Printf("deprecated PATCH body with update_info for %s\n", r.URL.Path)
+
+			values := r.URL.Query()
+			for key, value := range query {
+				values.Set(key, value)
+			}
+			r.URL.RawQuery = values.Encode()
+			r.Body = io.NopCloser(bytes.NewReader(updateInfo))
+			r.ContentLength = int64(len(updateInfo))
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// parseLegacyUpdateBody разбирает тело прежнего формата. ok false, если в теле нет update_info
+// или есть ключи, которых в прежнем формате не было: такое тело передается как есть
+func parseLegacyUpdateBody(body []byte) (updateInfo []byte, query map[string]string, ok bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, nil, false
+	}
+
+	query = make(map[string]string)
+	for key, value := range fields {
+		switch key {
+		case "update_info", "updateInfo":
+			updateInfo = value
+		case "expected_version", "expectedVersion":
+			// Int64Value в JSON - число или строка с числом
+			query["expected_version"] = strings.Trim(string(value), `"`)
+		case "uuid":
+			// uuid берется из пути
+		default:
+			return nil, nil, false
+		}
+	}
+
+	if updateInfo == nil || string(updateInfo) == "null" {
+		return nil, nil, false
+	}
+
+	return updateInfo, query, true
+}
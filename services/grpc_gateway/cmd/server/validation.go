@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// problemContentType тип тела ответа с ошибкой валидации (RFC 9457)
+const problemContentType = "application/problem+json"
+
+// validatorAll сообщения, для которых protoc-gen-validate сгенерировал проверки
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// pgvFieldError ошибка одного поля из кода protoc-gen-validate
+type pgvFieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// pgvMultiError все ошибки сообщения, собранные ValidateAll
+type pgvMultiError interface {
+	AllErrors() []error
+}
+
+// validationInterceptor проверяет каждый входящий запрос правилами из proto,
+// чтобы обработчикам не нужно было помнить о вызове Validate
+func validationInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if v, ok := req.(validatorAll); ok {
+		if err := v.ValidateAll(); err != nil {
+			return nil, validationStatus(err)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// validationStatus возвращает InvalidArgument с нарушениями в деталях errdetails.BadRequest
+func validationStatus(err error) error {
+	violations := fieldViolations("", err)
+
+	parts := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		parts = append(parts, violation.GetField()+": "+violation.GetDescription())
+	}
+
+	st, detailsErr := status.New(codes.InvalidArgument, "validation error: "+strings.Join(parts, "; ")).
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	return st.Err()
+}
+
+// fieldViolations раскладывает ошибки protoc-gen-validate по полям. Имена полей в них
+// записаны в стиле Go (UpdateInfo.DurationSeconds), в нарушения попадают пути proto (update_info.duration_seconds)
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	// Приведение типов, а не errors.As: иначе обертки полей пропускались бы вместе с путем
+	if multi, ok := err.(pgvMultiError); ok {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, fieldErr := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(prefix, fieldErr)...)
+		}
+		return violations
+	}
+
+	fieldErr, ok := err.(pgvFieldError)
+	if !ok {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+
+	field := snakeCase(fieldErr.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+
+	// Ошибка вложенного сообщения: нарушения берем из него самого
+	if fieldErr.Cause() != nil {
+		return fieldViolations(field, fieldErr.Cause())
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: fieldErr.Reason()}}
+}
+
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// problem тело ответа с ошибкой валидации в формате RFC 9457
+type problem struct {
+	Type       string             `json:"type"`
+	Title      string             `json:"title"`
+	Status     int                `json:"status"`
+	Detail     string             `json:"detail"`
+	Violations []problemViolation `json:"violations"`
+}
+
+type problemViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// writeValidationProblem отвечает 400 с application/problem+json, если в статусе есть
+// errdetails.BadRequest. Возвращает false для остальных ошибок
+func writeValidationProblem(w http.ResponseWriter, err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return false
+	}
+
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = br
+			break
+		}
+	}
+	if badRequest == nil {
+		return false
+	}
+
+	body := problem{
+		Type:       "about:blank",
+		Title:      http.StatusText(http.StatusBadRequest),
+		Status:     http.StatusBadRequest,
+		Detail:     st.Message(),
+		Violations: make([]problemViolation, 0, len(badRequest.GetFieldViolations())),
+	}
+	for _, violation := range badRequest.GetFieldViolations() {
+		body.Violations = append(body.Violations, problemViolation{
+			Field:       violation.GetField(),
+			Description: violation.GetDescription(),
+		})
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(http.StatusBadRequest)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("failed to write problem response: %v", err)
+	}
+
+	return true
+}
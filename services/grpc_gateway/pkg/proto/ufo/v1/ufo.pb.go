@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -31,17 +32,17 @@ const (
 // SightingInfo базовая информация о наблюдении НЛО
 type SightingInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// observed_at время наблюдения НЛО
+	// observed_at время наблюдения НЛО, не в будущем
 	ObservedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
 	// location место наблюдения
 	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
 	// description описание наблюдаемого объекта
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// color цвет объекта (опционально)
+	// color цвет объекта из известного списка, без учета регистра (опционально)
 	Color *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
 	// sound признак наличия звука (опционально)
 	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
-	// duration_seconds продолжительность наблюдения в секундах (опционально)
+	// duration_seconds продолжительность наблюдения в секундах, неотрицательная (опционально)
 	DurationSeconds *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -222,7 +223,9 @@ type Sighting struct {
 	// updated_at время последнего обновления записи
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// deleted_at время удаления записи (опционально)
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// version версия записи, увеличивается при каждом изменении (в HTTP API передается в заголовке ETag)
+	Version       int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -292,6 +295,13 @@ func (x *Sighting) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Sighting) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // CreateRequest запрос на создание наблюдения НЛО
 type CreateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -482,7 +492,13 @@ type UpdateRequest struct {
 	// uuid идентификатор наблюдения для обновления
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	// Обновляемая информация о наблюдении (частичное обновление)
-	UpdateInfo    *SightingUpdateInfo `protobuf:"bytes,2,opt,name=update_info,json=updateInfo,proto3" json:"update_info,omitempty"`
+	UpdateInfo *SightingUpdateInfo `protobuf:"bytes,2,opt,name=update_info,json=updateInfo,proto3" json:"update_info,omitempty"`
+	// expected_version ожидаемая текущая версия записи (опционально, в HTTP API - заголовок If-Match)
+	ExpectedVersion *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// update_mask поля update_info, которые нужно изменить (опционально). Поле из маски, не заданное
+	// в update_info, очищается; поля update_info вне маски игнорируются. Без маски меняются только
+	// заданные поля update_info
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -531,13 +547,29 @@ func (x *UpdateRequest) GetUpdateInfo() *SightingUpdateInfo {
 	return nil
 }
 
+func (x *UpdateRequest) GetExpectedVersion() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // DeleteRequest запрос на удаление наблюдения
 type DeleteRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// uuid идентификатор наблюдения для удаления
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// expected_version ожидаемая текущая версия записи (опционально, в HTTP API - заголовок If-Match)
+	ExpectedVersion *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteRequest) Reset() {
@@ -577,27 +609,173 @@ func (x *DeleteRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteRequest) GetExpectedVersion() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+// SightingFilter фильтр для выборки наблюдений (все поля опциональны)
+type SightingFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// observed_from нижняя граница времени наблюдения (включительно)
+	ObservedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_from,json=observedFrom,proto3" json:"observed_from,omitempty"`
+	// observed_to верхняя граница времени наблюдения (не включительно)
+	ObservedTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=observed_to,json=observedTo,proto3" json:"observed_to,omitempty"`
+	// location подстрока места наблюдения (без учета регистра)
+	Location *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// color точное совпадение цвета объекта
+	Color *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	// sound признак наличия звука
+	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
+	// include_deleted включать ли в выборку мягко удаленные наблюдения
+	IncludeDeleted bool `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SightingFilter) Reset() {
+	*x = SightingFilter{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SightingFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SightingFilter) ProtoMessage() {}
+
+func (x *SightingFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SightingFilter.ProtoReflect.Descriptor instead.
+func (*SightingFilter) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SightingFilter) GetObservedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedFrom
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetObservedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedTo
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetColor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetSound() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Sound
+	}
+	return nil
+}
+
+func (x *SightingFilter) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// ExportRequest запрос на выгрузку наблюдений
+type ExportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter условия выборки
+	Filter        *SightingFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportRequest) GetFilter() *SightingFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 var File_ufo_v1_ufo_proto protoreflect.FileDescriptor
 
 const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\n" +
-	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xc2\x02\n" +
-	"\fSightingInfo\x12;\n" +
-	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xfb\x04\n" +
+	"\fSightingInfo\x12E\n" +
+	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x028\x01R\n" +
 	"observedAt\x12%\n" +
-	"\blocation\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
-	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
-	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x02\n" +
-	"\x12SightingUpdateInfo\x12;\n" +
-	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"observedAt\x128\n" +
-	"\blocation\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12>\n" +
-	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x122\n" +
-	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
-	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x01\n" +
+	"\blocation\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12,\n" +
+	"\vdescription\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xd0\x0fR\vdescription\x12\xcb\x02\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueB\x96\x02\xfaB\x92\x02r\x8f\x022\x8c\x02^(?i)(белый|ч[её]рный|серый|серебристый|красный|оранжевый|ж[её]лтый|золотистый|зел[её]ный|голубой|синий|фиолетовый|розовый|коричневый|разноцветный)$R\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12O\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueB\a\xfaB\x04\x1a\x02(\x00R\x0fdurationSeconds\"\xbd\x05\n" +
+	"\x12SightingUpdateInfo\x12E\n" +
+	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x028\x01R\n" +
+	"observedAt\x12C\n" +
+	"\blocation\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12J\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xd0\x0fR\vdescription\x12\xcb\x02\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueB\x96\x02\xfaB\x92\x02r\x8f\x022\x8c\x02^(?i)(белый|ч[её]рный|серый|серебристый|красный|оранжевый|ж[её]лтый|золотистый|зел[её]ный|голубой|синий|фиолетовый|розовый|коричневый|разноцветный)$R\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12O\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueB\a\xfaB\x04\x1a\x02(\x00R\x0fdurationSeconds\"\x93\x02\n" +
 	"\bSighting\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12(\n" +
 	"\x04info\x18\x02 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\x129\n" +
@@ -606,7 +784,8 @@ const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
 	"\n" +
-	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"9\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\"9\n" +
 	"\rCreateRequest\x12(\n" +
 	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\"$\n" +
 	"\x0eCreateResponse\x12\x12\n" +
@@ -615,19 +794,34 @@ const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"GetRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
 	"\vGetResponse\x12,\n" +
-	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"`\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"\xe5\x01\n" +
 	"\rUpdateRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12;\n" +
 	"\vupdate_info\x18\x02 \x01(\v2\x1a.ufo.v1.SightingUpdateInfoR\n" +
-	"updateInfo\"#\n" +
+	"updateInfo\x12F\n" +
+	"\x10expected_version\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\x0fexpectedVersion\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"k\n" +
 	"\rDeleteRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid2\xd6\x02\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12F\n" +
+	"\x10expected_version\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x0fexpectedVersion\"\xd7\x02\n" +
+	"\x0eSightingFilter\x12?\n" +
+	"\robserved_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fobservedFrom\x12;\n" +
+	"\vobserved_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedTo\x128\n" +
+	"\blocation\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x122\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\"?\n" +
+	"\rExportRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.ufo.v1.SightingFilterR\x06filter2\x95\x03\n" +
 	"\n" +
 	"UFOService\x12O\n" +
 	"\x06Create\x12\x15.ufo.v1.CreateRequest\x1a\x16.ufo.v1.CreateResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/v1/ufo\x12J\n" +
-	"\x03Get\x12\x12.ufo.v1.GetRequest\x1a\x13.ufo.v1.GetResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/ufo/{uuid}\x12V\n" +
-	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/api/v1/ufo/{uuid}\x12S\n" +
-	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/ufo/{uuid}BIZGgithub.com/baizhigit/go-ms-examples/grpc_gateway/pkg/proto/ufo/v1;ufov1b\x06proto3"
+	"\x03Get\x12\x12.ufo.v1.GetRequest\x1a\x13.ufo.v1.GetResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/ufo/{uuid}\x12`\n" +
+	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\vupdate_info2\x12/api/v1/ufo/{uuid}\x12S\n" +
+	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/ufo/{uuid}\x123\n" +
+	"\x06Export\x12\x15.ufo.v1.ExportRequest\x1a\x10.ufo.v1.Sighting0\x01BIZGgithub.com/baizhigit/go-ms-examples/grpc_gateway/pkg/proto/ufo/v1;ufov1b\x06proto3"
 
 var (
 	file_ufo_v1_ufo_proto_rawDescOnce sync.Once
@@ -641,7 +835,7 @@ func file_ufo_v1_ufo_proto_rawDescGZIP() []byte {
 	return file_ufo_v1_ufo_proto_rawDescData
 }
 
-var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_ufo_v1_ufo_proto_goTypes = []any{
 	(*SightingInfo)(nil),           // 0: ufo.v1.SightingInfo
 	(*SightingUpdateInfo)(nil),     // 1: ufo.v1.SightingUpdateInfo
@@ -652,43 +846,58 @@ var file_ufo_v1_ufo_proto_goTypes = []any{
 	(*GetResponse)(nil),            // 6: ufo.v1.GetResponse
 	(*UpdateRequest)(nil),          // 7: ufo.v1.UpdateRequest
 	(*DeleteRequest)(nil),          // 8: ufo.v1.DeleteRequest
-	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil), // 10: google.protobuf.StringValue
-	(*wrapperspb.BoolValue)(nil),   // 11: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),  // 12: google.protobuf.Int32Value
-	(*emptypb.Empty)(nil),          // 13: google.protobuf.Empty
+	(*SightingFilter)(nil),         // 9: ufo.v1.SightingFilter
+	(*ExportRequest)(nil),          // 10: ufo.v1.ExportRequest
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil), // 12: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),   // 13: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),  // 14: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),  // 15: google.protobuf.Int64Value
+	(*fieldmaskpb.FieldMask)(nil),  // 16: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),          // 17: google.protobuf.Empty
 }
 var file_ufo_v1_ufo_proto_depIdxs = []int32{
-	9,  // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
-	10, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
-	11, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
-	12, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
-	9,  // 4: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
-	10, // 5: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
-	10, // 6: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
-	10, // 7: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
-	11, // 8: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
-	12, // 9: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	11, // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
+	12, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
+	13, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
+	14, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	11, // 4: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
+	12, // 5: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
+	12, // 6: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
+	12, // 7: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
+	13, // 8: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
+	14, // 9: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
 	0,  // 10: ufo.v1.Sighting.info:type_name -> ufo.v1.SightingInfo
-	9,  // 11: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 12: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 13: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
+	11, // 11: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
+	11, // 12: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 13: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
 	0,  // 14: ufo.v1.CreateRequest.info:type_name -> ufo.v1.SightingInfo
 	2,  // 15: ufo.v1.GetResponse.sighting:type_name -> ufo.v1.Sighting
 	1,  // 16: ufo.v1.UpdateRequest.update_info:type_name -> ufo.v1.SightingUpdateInfo
-	3,  // 17: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
-	5,  // 18: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
-	7,  // 19: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
-	8,  // 20: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
-	4,  // 21: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
-	6,  // 22: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
-	13, // 23: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
-	13, // 24: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
-	21, // [21:25] is the sub-list for method output_type
-	17, // [17:21] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	15, // 17: ufo.v1.UpdateRequest.expected_version:type_name -> google.protobuf.Int64Value
+	16, // 18: ufo.v1.UpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	15, // 19: ufo.v1.DeleteRequest.expected_version:type_name -> google.protobuf.Int64Value
+	11, // 20: ufo.v1.SightingFilter.observed_from:type_name -> google.protobuf.Timestamp
+	11, // 21: ufo.v1.SightingFilter.observed_to:type_name -> google.protobuf.Timestamp
+	12, // 22: ufo.v1.SightingFilter.location:type_name -> google.protobuf.StringValue
+	12, // 23: ufo.v1.SightingFilter.color:type_name -> google.protobuf.StringValue
+	13, // 24: ufo.v1.SightingFilter.sound:type_name -> google.protobuf.BoolValue
+	9,  // 25: ufo.v1.ExportRequest.filter:type_name -> ufo.v1.SightingFilter
+	3,  // 26: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
+	5,  // 27: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
+	7,  // 28: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
+	8,  // 29: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
+	10, // 30: ufo.v1.UFOService.Export:input_type -> ufo.v1.ExportRequest
+	4,  // 31: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
+	6,  // 32: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
+	17, // 33: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
+	17, // 34: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
+	2,  // 35: ufo.v1.UFOService.Export:output_type -> ufo.v1.Sighting
+	31, // [31:36] is the sub-list for method output_type
+	26, // [26:31] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_ufo_v1_ufo_proto_init() }
@@ -702,7 +911,7 @@ func file_ufo_v1_ufo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ufo_v1_ufo_proto_rawDesc), len(file_ufo_v1_ufo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -57,32 +57,33 @@ func (m *SightingInfo) validate(all bool) error {
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetObservedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "ObservedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "ObservedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetObservedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingInfoValidationError{
+	if t := m.GetObservedAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = SightingInfoValidationError{
 				field:  "ObservedAt",
-				reason: "embedded message failed validation",
+				reason: "value is not a valid timestamp",
 				cause:  err,
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) >= 0 {
+				err := SightingInfoValidationError{
+					field:  "ObservedAt",
+					reason: "value must be less than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
 		}
 	}
 
@@ -97,35 +98,30 @@ func (m *SightingInfo) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
-
-	if all {
-		switch v := interface{}(m.GetColor()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if l := utf8.RuneCountInString(m.GetDescription()); l < 1 || l > 2000 {
+		err := SightingInfoValidationError{
+			field:  "Description",
+			reason: "value length must be between 1 and 2000 runes, inclusive",
 		}
-	} else if v, ok := interface{}(m.GetColor()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingInfoValidationError{
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if wrapper := m.GetColor(); wrapper != nil {
+
+		if !_SightingInfo_Color_Pattern.MatchString(wrapper.GetValue()) {
+			err := SightingInfoValidationError{
 				field:  "Color",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value does not match regex pattern \"^(?i)(белый|ч[её]рный|серый|серебристый|красный|оранжевый|ж[её]лтый|золотистый|зел[её]ный|голубой|синий|фиолетовый|розовый|коричневый|разноцветный)$\"",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if all {
@@ -157,33 +153,19 @@ func (m *SightingInfo) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetDurationSeconds()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDurationSeconds()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingInfoValidationError{
+	if wrapper := m.GetDurationSeconds(); wrapper != nil {
+
+		if wrapper.GetValue() < 0 {
+			err := SightingInfoValidationError{
 				field:  "DurationSeconds",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value must be greater than or equal to 0",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if len(errors) > 0 {
@@ -263,6 +245,8 @@ var _ interface {
 	ErrorName() string
 } = SightingInfoValidationError{}
 
+var _SightingInfo_Color_Pattern = regexp.MustCompile("^(?i)(белый|ч[её]рный|серый|серебристый|красный|оранжевый|ж[её]лтый|золотистый|зел[её]ный|голубой|синий|фиолетовый|розовый|коричневый|разноцветный)$")
+
 // Validate checks the field values on SightingUpdateInfo with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -285,120 +269,79 @@ func (m *SightingUpdateInfo) validate(all bool) error {
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetObservedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "ObservedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "ObservedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetObservedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if t := m.GetObservedAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = SightingUpdateInfoValidationError{
 				field:  "ObservedAt",
-				reason: "embedded message failed validation",
+				reason: "value is not a valid timestamp",
 				cause:  err,
 			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetLocation()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Location",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Location",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+			if !all {
+				return err
 			}
-		}
-	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
-				field:  "Location",
-				reason: "embedded message failed validation",
-				cause:  err,
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) >= 0 {
+				err := SightingUpdateInfoValidationError{
+					field:  "ObservedAt",
+					reason: "value must be less than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
 			}
+
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetDescription()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Description",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Description",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if wrapper := m.GetLocation(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 50 {
+			err := SightingUpdateInfoValidationError{
+				field:  "Location",
+				reason: "value length must be between 1 and 50 runes, inclusive",
 			}
-		}
-	} else if v, ok := interface{}(m.GetDescription()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
-				field:  "Description",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetColor()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if wrapper := m.GetDescription(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 2000 {
+			err := SightingUpdateInfoValidationError{
+				field:  "Description",
+				reason: "value length must be between 1 and 2000 runes, inclusive",
 			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	} else if v, ok := interface{}(m.GetColor()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+
+	}
+
+	if wrapper := m.GetColor(); wrapper != nil {
+
+		if !_SightingUpdateInfo_Color_Pattern.MatchString(wrapper.GetValue()) {
+			err := SightingUpdateInfoValidationError{
 				field:  "Color",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value does not match regex pattern \"^(?i)(белый|ч[её]рный|серый|серебристый|красный|оранжевый|ж[её]лтый|золотистый|зел[её]ный|голубой|синий|фиолетовый|розовый|коричневый|разноцветный)$\"",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if all {
@@ -430,33 +373,19 @@ func (m *SightingUpdateInfo) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetDurationSeconds()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDurationSeconds()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetDurationSeconds(); wrapper != nil {
+
+		if wrapper.GetValue() < 0 {
+			err := SightingUpdateInfoValidationError{
 				field:  "DurationSeconds",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value must be greater than or equal to 0",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if len(errors) > 0 {
@@ -539,6 +468,8 @@ var _ interface {
 	ErrorName() string
 } = SightingUpdateInfoValidationError{}
 
+var _SightingUpdateInfo_Color_Pattern = regexp.MustCompile("^(?i)(белый|ч[её]рный|серый|серебристый|красный|оранжевый|ж[её]лтый|золотистый|зел[её]ный|голубой|синий|фиолетовый|розовый|коричневый|разноцветный)$")
+
 // Validate checks the field values on Sighting with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -679,6 +610,8 @@ func (m *Sighting) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Version
+
 	if len(errors) > 0 {
 		return SightingMultiError(errors)
 	}
@@ -1269,28 +1202,86 @@ func (m *UpdateRequest) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return UpdateRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// UpdateRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetExpectedVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRequestValidationError{
+					field:  "ExpectedVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRequestValidationError{
+					field:  "ExpectedVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpectedVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
 func (m UpdateRequestMultiError) AllErrors() []error { return m }
 
 // UpdateRequestValidationError is the validation error returned by
@@ -1371,6 +1362,35 @@ func (m *DeleteRequest) validate(all bool) error {
 
 	// no validation rules for Uuid
 
+	if all {
+		switch v := interface{}(m.GetExpectedVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteRequestValidationError{
+					field:  "ExpectedVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteRequestValidationError{
+					field:  "ExpectedVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpectedVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DeleteRequestMultiError(errors)
 	}
@@ -1448,3 +1468,379 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteRequestValidationError{}
+
+// Validate checks the field values on SightingFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SightingFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SightingFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SightingFilterMultiError,
+// or nil if none found.
+func (m *SightingFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SightingFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetObservedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "ObservedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "ObservedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObservedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingFilterValidationError{
+				field:  "ObservedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetObservedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "ObservedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "ObservedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObservedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingFilterValidationError{
+				field:  "ObservedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingFilterValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetColor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "Color",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "Color",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetColor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingFilterValidationError{
+				field:  "Color",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSound()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "Sound",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingFilterValidationError{
+					field:  "Sound",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSound()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingFilterValidationError{
+				field:  "Sound",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return SightingFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// SightingFilterMultiError is an error wrapping multiple validation errors
+// returned by SightingFilter.ValidateAll() if the designated constraints
+// aren't met.
+type SightingFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SightingFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SightingFilterMultiError) AllErrors() []error { return m }
+
+// SightingFilterValidationError is the validation error returned by
+// SightingFilter.Validate if the designated constraints aren't met.
+type SightingFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SightingFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SightingFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SightingFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SightingFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SightingFilterValidationError) ErrorName() string { return "SightingFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SightingFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSightingFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SightingFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SightingFilterValidationError{}
+
+// Validate checks the field values on ExportRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportRequestMultiError, or
+// nil if none found.
+func (m *ExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportRequestMultiError) AllErrors() []error { return m }
+
+// ExportRequestValidationError is the validation error returned by
+// ExportRequest.Validate if the designated constraints aren't met.
+type ExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRequestValidationError) ErrorName() string { return "ExportRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRequestValidationError{}
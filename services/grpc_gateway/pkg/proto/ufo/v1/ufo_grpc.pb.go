@@ -26,6 +26,7 @@ const (
 	UFOService_Get_FullMethodName    = "/ufo.v1.UFOService/Get"
 	UFOService_Update_FullMethodName = "/ufo.v1.UFOService/Update"
 	UFOService_Delete_FullMethodName = "/ufo.v1.UFOService/Delete"
+	UFOService_Export_FullMethodName = "/ufo.v1.UFOService/Export"
 )
 
 // UFOServiceClient is the client API for UFOService service.
@@ -38,10 +39,14 @@ type UFOServiceClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	// Get возвращает наблюдение НЛО по идентификатору
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// Update обновляет существующее наблюдение НЛО
+	// Update обновляет существующее наблюдение НЛО. Тело PATCH - update_info,
+	// если update_mask не передан, gateway строит его из полей JSON тела
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Export потоково выгружает все наблюдения НЛО, подходящие под фильтр.
+	// В HTTP API доступен как GET /api/v1/ufo/export?format=csv|ndjson (обработчик на сервере gateway).
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Sighting], error)
 }
 
 type uFOServiceClient struct {
@@ -92,6 +97,25 @@ func (c *uFOServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts .
 	return out, nil
 }
 
+func (c *uFOServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Sighting], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[0], UFOService_Export_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, Sighting]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_ExportClient = grpc.ServerStreamingClient[Sighting]
+
 // UFOServiceServer is the server API for UFOService service.
 // All implementations must embed UnimplementedUFOServiceServer
 // for forward compatibility.
@@ -102,10 +126,14 @@ type UFOServiceServer interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	// Get возвращает наблюдение НЛО по идентификатору
 	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// Update обновляет существующее наблюдение НЛО
+	// Update обновляет существующее наблюдение НЛО. Тело PATCH - update_info,
+	// если update_mask не передан, gateway строит его из полей JSON тела
 	Update(context.Context, *UpdateRequest) (*emptypb.Empty, error)
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// Export потоково выгружает все наблюдения НЛО, подходящие под фильтр.
+	// В HTTP API доступен как GET /api/v1/ufo/export?format=csv|ndjson (обработчик на сервере gateway).
+	Export(*ExportRequest, grpc.ServerStreamingServer[Sighting]) error
 	mustEmbedUnimplementedUFOServiceServer()
 }
 
@@ -128,6 +156,9 @@ func (UnimplementedUFOServiceServer) Update(context.Context, *UpdateRequest) (*e
 func (UnimplementedUFOServiceServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUFOServiceServer) Export(*ExportRequest, grpc.ServerStreamingServer[Sighting]) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedUFOServiceServer) mustEmbedUnimplementedUFOServiceServer() {}
 func (UnimplementedUFOServiceServer) testEmbeddedByValue()                    {}
 
@@ -221,6 +252,17 @@ func _UFOService_Delete_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UFOService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UFOServiceServer).Export(m, &grpc.GenericServerStream[ExportRequest, Sighting]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_ExportServer = grpc.ServerStreamingServer[Sighting]
+
 // UFOService_ServiceDesc is the grpc.ServiceDesc for UFOService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -245,6 +287,12 @@ var UFOService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _UFOService_Delete_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _UFOService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ufo/v1/ufo.proto",
 }
@@ -7,17 +7,28 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	ufoV1API "github.com/baizhigit/go-ms-examples/layers/internal/api/ufo/v1"
+	attachmentStorage "github.com/baizhigit/go-ms-examples/layers/internal/repository/filesystem"
 	ufoRepository "github.com/baizhigit/go-ms-examples/layers/internal/repository/ufo"
+	attachmentService "github.com/baizhigit/go-ms-examples/layers/internal/service/attachment"
 	ufoService "github.com/baizhigit/go-ms-examples/layers/internal/service/ufo"
 	ufoV1 "github.com/baizhigit/go-ms-examples/layers/pkg/proto/ufo/v1"
 )
 
-const grpcPort = 50051
+const (
+	grpcPort        = 50051
+	shutdownTimeout = 5 * time.Second
+
+	// attachmentsDir каталог, в котором хранится содержимое вложений
+	attachmentsDir = "./data/attachments"
+	// maxAttachmentSize максимальный размер одного вложения
+	maxAttachmentSize = 50 << 20
+)
 
 func main() {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
@@ -37,7 +48,15 @@ func main() {
 	// Регистрируем наш сервис
 	repo := ufoRepository.NewRepository()
 	service := ufoService.NewService(repo)
-	api := ufoV1API.NewAPI(service)
+
+	storage, err := attachmentStorage.NewStorage(attachmentsDir)
+	if err != nil {
+		log.Printf("failed to create attachment storage: %v\n", err)
+		return
+	}
+	attachments := attachmentService.NewService(repo, storage, maxAttachmentSize)
+
+	api := ufoV1API.NewAPI(service, attachments)
 
 	ufoV1.RegisterUFOServiceServer(s, api)
 
@@ -58,6 +77,20 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("🛑 Shutting down gRPC server...")
-	s.GracefulStop()
+
+	// Подписки WatchSightings не завершаются сами, поэтому после таймаута
+	// оставшиеся стримы обрываются принудительно
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		s.Stop()
+	}
+
 	log.Println("✅ Server stopped")
 }
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/converter"
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	ufoV1 "github.com/baizhigit/go-ms-examples/layers/pkg/proto/ufo/v1"
+)
+
+// downloadChunkSize размер части содержимого в одном сообщении DownloadAttachment
+const downloadChunkSize = 64 << 10
+
+func (a *api) UploadAttachment(stream ufoV1.UFOService_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "upload must start with metadata")
+		}
+		return err
+	}
+
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return status.Error(codes.InvalidArgument, "upload must start with metadata")
+	}
+
+	attachment, err := a.attachmentService.Upload(ctx, model.AttachmentUpload{
+		SightingUuid: metadata.GetSightingUuid(),
+		FileName:     metadata.GetFileName(),
+	}, &chunkReader{stream: stream})
+	if err != nil {
+		return attachmentStatus(err, metadata.GetSightingUuid())
+	}
+
+	return stream.SendAndClose(&ufoV1.UploadAttachmentResponse{
+		Attachment: converter.AttachmentToProto(attachment),
+	})
+}
+
+func (a *api) DownloadAttachment(req *ufoV1.DownloadAttachmentRequest, stream ufoV1.UFOService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+
+	attachment, content, err := a.attachmentService.Download(ctx, req.GetSightingUuid(), req.GetAttachmentId())
+	if err != nil {
+		return attachmentStatus(err, req.GetSightingUuid())
+	}
+	defer func() {
+		cerr := content.Close()
+		if cerr != nil {
+			log.Printf("failed to close attachment content: %v\n", cerr)
+		}
+	}()
+
+	err = stream.Send(&ufoV1.DownloadAttachmentResponse{
+		Payload: &ufoV1.DownloadAttachmentResponse_Attachment{Attachment: converter.AttachmentToProto(attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, rerr := content.Read(buf)
+		if n > 0 {
+			// Send сериализует сообщение сразу, поэтому буфер можно переиспользовать
+			err = stream.Send(&ufoV1.DownloadAttachmentResponse{
+				Payload: &ufoV1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if errors.Is(rerr, io.EOF) {
+			return nil
+		}
+		if rerr != nil {
+			return attachmentStatus(rerr, req.GetSightingUuid())
+		}
+	}
+}
+
+// chunkReader читает содержимое вложения из сообщений клиентского стрима,
+// io.EOF от Recv означает конец файла
+type chunkReader struct {
+	stream ufoV1.UFOService_UploadAttachmentServer
+	chunk  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if req.GetMetadata() != nil {
+			return 0, fmt.Errorf("%w: metadata is allowed only in the first message", model.ErrInvalidAttachmentUpload)
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
+
+// attachmentStatus приводит ошибку работы с вложением к gRPC статусу
+func attachmentStatus(err error, sightingUuid string) error {
+	switch {
+	case errors.Is(err, model.ErrSightingNotFound):
+		return status.Errorf(codes.NotFound, "sighting with UUID %s not found", sightingUuid)
+	case errors.Is(err, model.ErrAttachmentNotFound):
+		return status.Error(codes.NotFound, "attachment not found")
+	case errors.Is(err, model.ErrInvalidAttachmentUpload),
+		errors.Is(err, model.ErrEmptyAttachment),
+		errors.Is(err, model.ErrAttachmentTooLarge),
+		errors.Is(err, model.ErrUnsupportedAttachmentType):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return err
+	}
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/converter"
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	ufoV1 "github.com/baizhigit/go-ms-examples/layers/pkg/proto/ufo/v1"
+)
+
+func (a *api) GetStats(ctx context.Context, req *ufoV1.GetStatsRequest) (*ufoV1.GetStatsResponse, error) {
+	stats, err := a.ufoService.GetStats(ctx, converter.StatsRequestToModel(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidStatsGroupBy):
+			return nil, status.Error(codes.InvalidArgument, "group_by must be one of day, week, month, color, sound")
+		case errors.Is(err, model.ErrInvalidStatsRange), errors.Is(err, model.ErrStatsRangeTooLarge):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	return converter.StatsToProto(stats), nil
+}
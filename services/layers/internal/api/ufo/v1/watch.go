@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/converter"
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	ufoV1 "github.com/baizhigit/go-ms-examples/layers/pkg/proto/ufo/v1"
+)
+
+func (a *api) WatchSightings(req *ufoV1.WatchSightingsRequest, stream ufoV1.UFOService_WatchSightingsServer) error {
+	ctx := stream.Context()
+
+	events, err := a.ufoService.Watch(ctx, req.GetResumeToken())
+	if err != nil {
+		return watchStatus(err)
+	}
+	defer func() {
+		if cerr := events.Close(context.WithoutCancel(ctx)); cerr != nil {
+			log.Printf("failed to close sighting event stream: %v\n", cerr)
+		}
+	}()
+
+	for {
+		event, err := events.Next(ctx)
+		if err != nil {
+			return watchStatus(err)
+		}
+
+		err = stream.Send(converter.SightingEventToProto(event))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// watchStatus приводит ошибку подписки к gRPC статусу
+func watchStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidResumeToken):
+		return status.Error(codes.InvalidArgument, "invalid resume token")
+	case errors.Is(err, model.ErrResumeTokenExpired):
+		return status.Error(codes.OutOfRange, "resume token expired, reload sightings and watch without resume token")
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return err
+	}
+}
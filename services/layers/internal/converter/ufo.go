@@ -103,11 +103,12 @@ func SightingToProto(sighting model.Sighting) *ufoV1.Sighting {
 	}
 
 	return &ufoV1.Sighting{
-		Uuid:      sighting.Uuid,
-		Info:      SightingInfoToProto(sighting.Info),
-		CreatedAt: timestamppb.New(sighting.CreatedAt),
-		UpdatedAt: updatedAt,
-		DeletedAt: deletedAt,
+		Uuid:        sighting.Uuid,
+		Info:        SightingInfoToProto(sighting.Info),
+		CreatedAt:   timestamppb.New(sighting.CreatedAt),
+		UpdatedAt:   updatedAt,
+		DeletedAt:   deletedAt,
+		Attachments: lo.Map(sighting.Attachments, func(attachment model.Attachment, _ int) *ufoV1.Attachment { return AttachmentToProto(attachment) }),
 	}
 }
 
@@ -141,3 +142,134 @@ func SightingInfoToProto(info model.SightingInfo) *ufoV1.SightingInfo {
 		DurationSeconds: durationSeconds,
 	}
 }
+
+func SightingEventToProto(event model.SightingEvent) *ufoV1.SightingEvent {
+	return &ufoV1.SightingEvent{
+		Type:        SightingEventTypeToProto(event.Type),
+		Uuid:        event.Sighting.Uuid,
+		Sighting:    SightingToProto(event.Sighting),
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+		ResumeToken: event.ResumeToken,
+	}
+}
+
+func SightingEventTypeToProto(eventType model.SightingEventType) ufoV1.SightingEventType {
+	switch eventType {
+	case model.SightingEventTypeCreated:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_CREATED
+	case model.SightingEventTypeUpdated:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_UPDATED
+	case model.SightingEventTypeDeleted:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_DELETED
+	default:
+		return ufoV1.SightingEventType_SIGHTING_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func SearchHitsToProto(hits []model.SightingSearchHit) *ufoV1.SearchResponse {
+	protoHits := make([]*ufoV1.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		highlights := make([]*ufoV1.SearchHighlight, 0, len(hit.Highlights))
+		for _, highlight := range hit.Highlights {
+			highlights = append(highlights, &ufoV1.SearchHighlight{
+				Field:    highlight.Field,
+				Fragment: highlight.Fragment,
+			})
+		}
+
+		protoHits = append(protoHits, &ufoV1.SearchHit{
+			Sighting:   SightingToProto(hit.Sighting),
+			Score:      hit.Score,
+			Highlights: highlights,
+		})
+	}
+
+	return &ufoV1.SearchResponse{
+		Hits: protoHits,
+	}
+}
+
+func StatsRequestToModel(req *ufoV1.GetStatsRequest) model.StatsQuery {
+	var observedFrom *time.Time
+	if req.ObservedFrom != nil {
+		observedFrom = lo.ToPtr(req.ObservedFrom.AsTime())
+	}
+
+	var observedTo *time.Time
+	if req.ObservedTo != nil {
+		observedTo = lo.ToPtr(req.ObservedTo.AsTime())
+	}
+
+	return model.StatsQuery{
+		ObservedFrom: observedFrom,
+		ObservedTo:   observedTo,
+		GroupBy:      StatsGroupByToModel(req.GroupBy),
+	}
+}
+
+func StatsGroupByToModel(groupBy ufoV1.StatsGroupBy) model.StatsGroupBy {
+	switch groupBy {
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_DAY:
+		return model.StatsGroupByDay
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_WEEK:
+		return model.StatsGroupByWeek
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_MONTH:
+		return model.StatsGroupByMonth
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_COLOR:
+		return model.StatsGroupByColor
+	case ufoV1.StatsGroupBy_STATS_GROUP_BY_SOUND:
+		return model.StatsGroupBySound
+	default:
+		return model.StatsGroupByUnspecified
+	}
+}
+
+func StatsGroupByToProto(groupBy model.StatsGroupBy) ufoV1.StatsGroupBy {
+	switch groupBy {
+	case model.StatsGroupByDay:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_DAY
+	case model.StatsGroupByWeek:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_WEEK
+	case model.StatsGroupByMonth:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_MONTH
+	case model.StatsGroupByColor:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_COLOR
+	case model.StatsGroupBySound:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_SOUND
+	default:
+		return ufoV1.StatsGroupBy_STATS_GROUP_BY_UNSPECIFIED
+	}
+}
+
+func StatsToProto(stats model.Stats) *ufoV1.GetStatsResponse {
+	buckets := make([]*ufoV1.StatsBucket, 0, len(stats.Buckets))
+	for _, bucket := range stats.Buckets {
+		var start *timestamppb.Timestamp
+		if bucket.Start != nil {
+			start = timestamppb.New(*bucket.Start)
+		}
+
+		buckets = append(buckets, &ufoV1.StatsBucket{
+			Key:   bucket.Key,
+			Start: start,
+			Count: bucket.Count,
+		})
+	}
+
+	return &ufoV1.GetStatsResponse{
+		GroupBy: StatsGroupByToProto(stats.GroupBy),
+		Buckets: buckets,
+		Total:   stats.Total,
+	}
+}
+
+func AttachmentToProto(attachment model.Attachment) *ufoV1.Attachment {
+	return &ufoV1.Attachment{
+		Id:          attachment.Id,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		Sha256:      attachment.Sha256,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+}
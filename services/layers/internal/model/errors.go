@@ -2,4 +2,19 @@ package model
 
 import "errors"
 
-var ErrSightingNotFound = errors.New("sighting not found")
+var (
+	ErrSightingNotFound   = errors.New("sighting not found")
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	ErrResumeTokenExpired = errors.New("resume token expired")
+	ErrEmptySearchQuery   = errors.New("search query is empty")
+
+	ErrInvalidStatsGroupBy = errors.New("invalid stats grouping")
+	ErrInvalidStatsRange   = errors.New("invalid stats range")
+	ErrStatsRangeTooLarge  = errors.New("stats range is too large")
+
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrInvalidAttachmentUpload   = errors.New("invalid attachment upload")
+	ErrEmptyAttachment           = errors.New("attachment is empty")
+	ErrAttachmentTooLarge        = errors.New("attachment is too large")
+	ErrUnsupportedAttachmentType = errors.New("unsupported attachment type")
+)
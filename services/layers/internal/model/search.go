@@ -0,0 +1,20 @@
+package model
+
+type SightingSearchQuery struct {
+	// Terms слова запроса в нижнем регистре, наблюдение подходит, если содержит любое из них
+	Terms []string
+	Limit int32
+}
+
+type SightingSearchHit struct {
+	Sighting Sighting
+	// Score релевантность, чем больше, тем выше в выдаче; зависит от частоты слов в наблюдении и во всей коллекции
+	Score      float64
+	Highlights []SearchHighlight
+}
+
+// SearchHighlight фрагмент поля наблюдения, в котором найденные слова обернуты в <em>...</em>
+type SearchHighlight struct {
+	Field    string
+	Fragment string
+}
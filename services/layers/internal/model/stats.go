@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+type StatsGroupBy int
+
+const (
+	StatsGroupByUnspecified StatsGroupBy = iota
+	StatsGroupByDay
+	StatsGroupByWeek
+	StatsGroupByMonth
+	StatsGroupByColor
+	StatsGroupBySound
+)
+
+// IsTime группировка по времени наблюдения
+func (g StatsGroupBy) IsTime() bool {
+	return g == StatsGroupByDay || g == StatsGroupByWeek || g == StatsGroupByMonth
+}
+
+type StatsQuery struct {
+	// ObservedFrom и ObservedTo ограничивают период по времени наблюдения: [from, to)
+	ObservedFrom *time.Time
+	ObservedTo   *time.Time
+	GroupBy      StatsGroupBy
+}
+
+type StatsBucket struct {
+	// Key подпись группы; для цвета и звука пустая, если значение не указано.
+	// Для группировки по времени репозиторий оставляет ее пустой, подпись по Start строит сервис
+	Key string
+	// Start начало интервала в UTC, только для группировки по времени
+	Start *time.Time
+	Count int64
+}
+
+type Stats struct {
+	GroupBy StatsGroupBy
+	Buckets []StatsBucket
+	Total   int64
+}
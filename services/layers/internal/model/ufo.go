@@ -23,9 +23,10 @@ type SightingUpdateInfo struct {
 }
 
 type Sighting struct {
-	Uuid      string
-	Info      SightingInfo
-	CreatedAt time.Time
-	UpdatedAt *time.Time
-	DeletedAt *time.Time
+	Uuid        string
+	Info        SightingInfo
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+	DeletedAt   *time.Time
+	Attachments []Attachment
 }
@@ -0,0 +1,51 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+)
+
+func (s *UFORepositorySuite) TestCreateAndGet() {
+	info := sightingInfo(time.Now().Add(-time.Hour))
+	before := time.Now().Add(-time.Second)
+
+	id := s.create(info)
+
+	sighting := s.get(id)
+	s.Equal(id, sighting.Uuid)
+	s.equalInfo(info, sighting.Info)
+	s.WithinRange(sighting.CreatedAt, before, time.Now().Add(time.Second))
+	s.Nil(sighting.UpdatedAt)
+	s.Nil(sighting.DeletedAt)
+}
+
+func (s *UFORepositorySuite) TestCreateOptionalFieldsEmpty() {
+	info := model.SightingInfo{
+		Location:    "Где-то в степи",
+		Description: "Без подробностей",
+	}
+
+	sighting := s.get(s.create(info))
+	s.Nil(sighting.Info.ObservedAt)
+	s.Nil(sighting.Info.Color)
+	s.Nil(sighting.Info.Sound)
+	s.Nil(sighting.Info.DurationSeconds)
+	s.equalInfo(info, sighting.Info)
+}
+
+func (s *UFORepositorySuite) TestCreateGeneratesUniqueUUIDs() {
+	info := sightingInfo(time.Now())
+
+	first := s.create(info)
+	second := s.create(info)
+
+	s.NotEqual(first, second)
+}
+
+func (s *UFORepositorySuite) TestGetNotFound() {
+	_, err := s.repo.Get(s.ctx, uuid.NewString())
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
@@ -0,0 +1,30 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+)
+
+func (s *UFORepositorySuite) TestDeleteIsSoft() {
+	info := sightingInfo(time.Now())
+	id := s.create(info)
+	before := time.Now().Add(-time.Second)
+
+	err := s.repo.Delete(s.ctx, id)
+	s.Require().NoError(err)
+
+	// Мягко удаленное наблюдение остается доступным с отметкой времени удаления
+	deleted := s.get(id)
+	s.equalInfo(info, deleted.Info)
+	if s.NotNil(deleted.DeletedAt) {
+		s.WithinRange(*deleted.DeletedAt, before, time.Now().Add(time.Second))
+	}
+}
+
+func (s *UFORepositorySuite) TestDeleteNotFound() {
+	err := s.repo.Delete(s.ctx, uuid.NewString())
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
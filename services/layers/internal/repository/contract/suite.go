@@ -0,0 +1,108 @@
+// Package contract содержит общий набор тестов, которому должна удовлетворять любая
+// реализация repository.UFORepository: создание и чтение, частичное обновление, мягкое
+// удаление, ошибки отсутствующего наблюдения и параллельные изменения.
+//
+// Реализация подключает набор в своем _test.go файле:
+//
+//	func TestRepositoryContract(t *testing.T) {
+//		suite.Run(t, contract.NewUFORepositorySuite(func(t *testing.T) repository.UFORepository {
+//			return NewRepository()
+//		}))
+//	}
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	"github.com/baizhigit/go-ms-examples/layers/internal/repository"
+)
+
+// RepositoryFactory возвращает пустой репозиторий для одного теста.
+// Освобождение ресурсов фабрика регистрирует через t.Cleanup.
+type RepositoryFactory func(t *testing.T) repository.UFORepository
+
+type UFORepositorySuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	newRepository RepositoryFactory
+
+	repo repository.UFORepository
+}
+
+func NewUFORepositorySuite(newRepository RepositoryFactory) *UFORepositorySuite {
+	return &UFORepositorySuite{
+		newRepository: newRepository,
+	}
+}
+
+func (s *UFORepositorySuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.repo = s.newRepository(s.T())
+}
+
+// timestamp возвращает момент времени с точностью до миллисекунд:
+// MongoDB не хранит более точные значения
+func timestamp(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Millisecond)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// sightingInfo возвращает заполненные данные наблюдения
+func sightingInfo(observedAt time.Time) model.SightingInfo {
+	return model.SightingInfo{
+		ObservedAt:      ptr(timestamp(observedAt)),
+		Location:        "Алматы, Медеу",
+		Description:     "Светящийся треугольник над горами",
+		Color:           ptr("зеленый"),
+		Sound:           ptr(true),
+		DurationSeconds: ptr(int32(300)),
+	}
+}
+
+// create создает наблюдение и проверяет, что операция прошла успешно
+func (s *UFORepositorySuite) create(info model.SightingInfo) string {
+	uuid, err := s.repo.Create(s.ctx, info)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(uuid)
+
+	return uuid
+}
+
+// get читает наблюдение и проверяет, что операция прошла успешно
+func (s *UFORepositorySuite) get(uuid string) model.Sighting {
+	sighting, err := s.repo.Get(s.ctx, uuid)
+	s.Require().NoError(err)
+
+	return sighting
+}
+
+// equalTime сравнивает моменты времени без учета часового пояса
+func (s *UFORepositorySuite) equalTime(expected, actual *time.Time) {
+	if expected == nil {
+		s.Nil(actual)
+		return
+	}
+
+	if s.NotNil(actual) {
+		s.True(expected.Equal(*actual), "expected %s, got %s", expected, actual)
+	}
+}
+
+// equalInfo сравнивает данные наблюдения без учета часового пояса observed_at
+func (s *UFORepositorySuite) equalInfo(expected, actual model.SightingInfo) {
+	s.equalTime(expected.ObservedAt, actual.ObservedAt)
+
+	expected.ObservedAt, actual.ObservedAt = nil, nil
+	s.Equal(expected, actual)
+}
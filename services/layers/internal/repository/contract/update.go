@@ -0,0 +1,60 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+)
+
+func (s *UFORepositorySuite) TestUpdateAllFields() {
+	id := s.create(sightingInfo(time.Now().Add(-time.Hour)))
+	created := s.get(id)
+
+	newObservedAt := timestamp(time.Now().Add(-30 * time.Minute))
+	updateInfo := model.SightingUpdateInfo{
+		ObservedAt:      &newObservedAt,
+		Location:        ptr("Астана, Байтерек"),
+		Description:     ptr("Шар, зависший над башней"),
+		Color:           ptr("красный"),
+		Sound:           ptr(false),
+		DurationSeconds: ptr(int32(42)),
+	}
+
+	err := s.repo.Update(s.ctx, id, updateInfo)
+	s.Require().NoError(err)
+
+	stored := s.get(id)
+	s.equalInfo(model.SightingInfo{
+		ObservedAt:      &newObservedAt,
+		Location:        *updateInfo.Location,
+		Description:     *updateInfo.Description,
+		Color:           updateInfo.Color,
+		Sound:           updateInfo.Sound,
+		DurationSeconds: updateInfo.DurationSeconds,
+	}, stored.Info)
+	s.NotNil(stored.UpdatedAt)
+	s.True(created.CreatedAt.Equal(stored.CreatedAt), "created_at changed on update")
+}
+
+func (s *UFORepositorySuite) TestUpdatePartialKeepsOtherFields() {
+	info := sightingInfo(time.Now().Add(-time.Hour))
+	id := s.create(info)
+
+	err := s.repo.Update(s.ctx, id, model.SightingUpdateInfo{
+		Description: ptr("Уточненное описание"),
+	})
+	s.Require().NoError(err)
+
+	expected := info
+	expected.Description = "Уточненное описание"
+	s.equalInfo(expected, s.get(id).Info)
+}
+
+func (s *UFORepositorySuite) TestUpdateNotFound() {
+	err := s.repo.Update(s.ctx, uuid.NewString(), model.SightingUpdateInfo{
+		Description: ptr("Правка несуществующего"),
+	})
+	s.ErrorIs(err, model.ErrSightingNotFound)
+}
@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"github.com/samber/lo"
+
 	"github.com/baizhigit/go-ms-examples/layers/internal/model"
 	repoModel "github.com/baizhigit/go-ms-examples/layers/internal/repository/model"
 )
@@ -18,14 +20,43 @@ func SightingInfoToRepoModel(info model.SightingInfo) repoModel.SightingInfo {
 
 func SightingToModel(sighting repoModel.Sighting) model.Sighting {
 	return model.Sighting{
-		Uuid:      sighting.Uuid,
-		Info:      SightingInfoToModel(sighting.Info),
-		CreatedAt: sighting.CreatedAt,
-		UpdatedAt: sighting.UpdatedAt,
-		DeletedAt: sighting.DeletedAt,
+		Uuid:        sighting.Uuid,
+		Info:        SightingInfoToModel(sighting.Info),
+		CreatedAt:   sighting.CreatedAt,
+		UpdatedAt:   sighting.UpdatedAt,
+		DeletedAt:   sighting.DeletedAt,
+		Attachments: AttachmentsToModel(sighting.Attachments),
+	}
+}
+
+func AttachmentToRepoModel(attachment model.Attachment) repoModel.Attachment {
+	return repoModel.Attachment{
+		Id:          attachment.Id,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		Sha256:      attachment.Sha256,
+		CreatedAt:   attachment.CreatedAt,
 	}
 }
 
+func AttachmentsToModel(attachments []repoModel.Attachment) []model.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	return lo.Map(attachments, func(attachment repoModel.Attachment, _ int) model.Attachment {
+		return model.Attachment{
+			Id:          attachment.Id,
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			SizeBytes:   attachment.SizeBytes,
+			Sha256:      attachment.Sha256,
+			CreatedAt:   attachment.CreatedAt,
+		}
+	})
+}
+
 func SightingInfoToModel(info repoModel.SightingInfo) model.SightingInfo {
 	return model.SightingInfo{
 		ObservedAt:      info.ObservedAt,
@@ -0,0 +1,109 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	def "github.com/baizhigit/go-ms-examples/layers/internal/repository"
+)
+
+var _ def.AttachmentStorage = (*storage)(nil)
+
+const (
+	dirPerm = 0o750
+	// tempPattern временный файл, в который пишется содержимое до успешного завершения загрузки
+	tempPattern = ".upload-*"
+)
+
+// storage хранит содержимое каждого вложения отдельным файлом в каталоге dir
+type storage struct {
+	dir string
+}
+
+func NewStorage(dir string) (*storage, error) {
+	err := os.MkdirAll(dir, dirPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachments directory: %w", err)
+	}
+
+	return &storage{
+		dir: dir,
+	}, nil
+}
+
+func (s *storage) Save(_ context.Context, id string, content io.Reader) error {
+	path, err := s.path(id)
+	if err != nil {
+		return err
+	}
+
+	// Файл появляется под своим именем только целиком, поэтому Open не увидит недописанное содержимое
+	tmp, err := os.CreateTemp(s.dir, tempPattern)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tmp, content)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return nil
+}
+
+func (s *storage) Open(_ context.Context, id string) (io.ReadCloser, error) {
+	path, err := s.path(id)
+	if err != nil {
+		return nil, model.ErrAttachmentNotFound
+	}
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, model.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (s *storage) Delete(_ context.Context, id string) error {
+	path, err := s.path(id)
+	if err != nil {
+		return nil
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// path возвращает путь к файлу вложения; идентификатор не может указывать за пределы каталога
+func (s *storage) path(id string) (string, error) {
+	if id == "" || !filepath.IsLocal(id) || strings.ContainsAny(id, `/\`) || strings.HasPrefix(id, ".") {
+		return "", fmt.Errorf("invalid attachment id %q", id)
+	}
+
+	return filepath.Join(s.dir, id), nil
+}
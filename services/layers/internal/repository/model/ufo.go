@@ -21,9 +21,19 @@ type SightingUpdateInfo struct {
 }
 
 type Sighting struct {
-	Uuid      string
-	Info      SightingInfo
-	CreatedAt time.Time
-	UpdatedAt *time.Time
-	DeletedAt *time.Time
+	Uuid        string
+	Info        SightingInfo
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+	DeletedAt   *time.Time
+	Attachments []Attachment
+}
+
+type Attachment struct {
+	Id          string
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	Sha256      string
+	CreatedAt   time.Time
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"io"
 
 	"github.com/baizhigit/go-ms-examples/layers/internal/model"
 )
@@ -11,4 +12,17 @@ type UFORepository interface {
 	Get(ctx context.Context, uuid string) (model.Sighting, error)
 	Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo) error
 	Delete(ctx context.Context, uuid string) error
+	Watch(ctx context.Context, resumeToken string) (model.SightingEventStream, error)
+	Search(ctx context.Context, query model.SightingSearchQuery) ([]model.SightingSearchHit, error)
+	Stats(ctx context.Context, query model.StatsQuery) ([]model.StatsBucket, error)
+	AddAttachment(ctx context.Context, uuid string, attachment model.Attachment) (model.Sighting, error)
+}
+
+// AttachmentStorage хранит содержимое вложений; метаданные вложений хранятся в наблюдении
+type AttachmentStorage interface {
+	// Save сохраняет содержимое целиком; при ошибке чтения content ничего не остается в хранилище
+	Save(ctx context.Context, id string, content io.Reader) error
+	// Open открывает содержимое для чтения, model.ErrAttachmentNotFound, если его нет
+	Open(ctx context.Context, id string) (io.ReadCloser, error)
+	Delete(ctx context.Context, id string) error
 }
@@ -0,0 +1,33 @@
+package ufo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/layers/internal/repository/converter"
+)
+
+func (r *repository) AddAttachment(_ context.Context, uuid string, attachment model.Attachment) (model.Sighting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sighting, ok := r.data[uuid]
+	if !ok {
+		return model.Sighting{}, model.ErrSightingNotFound
+	}
+
+	// Копия списка, чтобы не менять состояние, уже отданное в журнал событий
+	sighting.Attachments = append(slices.Clone(sighting.Attachments), repoConverter.AttachmentToRepoModel(attachment))
+
+	now := time.Now()
+	sighting.UpdatedAt = lo.ToPtr(now)
+
+	r.data[uuid] = sighting
+	r.publish(model.SightingEventTypeUpdated, sighting, now)
+
+	return repoConverter.SightingToModel(sighting), nil
+}
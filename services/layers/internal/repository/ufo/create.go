@@ -13,15 +13,20 @@ import (
 
 func (r *repository) Create(_ context.Context, info model.SightingInfo) (string, error) {
 	newUUID := uuid.NewString()
+	now := time.Now()
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.data[newUUID] = repoModel.Sighting{
+	sighting := repoModel.Sighting{
 		Uuid:      newUUID,
 		Info:      repoConverter.SightingInfoToRepoModel(info),
-		CreatedAt: time.Now(),
+		CreatedAt: now,
 	}
 
+	r.data[newUUID] = sighting
+	r.index.add(newUUID, sighting.Info)
+	r.publish(model.SightingEventTypeCreated, sighting, now)
+
 	return newUUID, nil
 }
@@ -18,10 +18,17 @@ func (r *repository) Delete(_ context.Context, uuid string) error {
 		return model.ErrSightingNotFound
 	}
 
+	// Удаленные наблюдения не ищутся
+	if sighting.DeletedAt == nil {
+		r.index.remove(uuid, sighting.Info)
+	}
+
 	// Мягкое удаление - устанавливаем deleted_at
-	sighting.DeletedAt = lo.ToPtr(time.Now())
+	now := time.Now()
+	sighting.DeletedAt = lo.ToPtr(now)
 
 	r.data[uuid] = sighting
+	r.publish(model.SightingEventTypeDeleted, sighting, now)
 
 	return nil
 }
@@ -0,0 +1,96 @@
+package ufo
+
+import (
+	"math"
+
+	repoModel "github.com/baizhigit/go-ms-examples/layers/internal/repository/model"
+	"github.com/baizhigit/go-ms-examples/layers/internal/search"
+)
+
+// Совпадение в месте наблюдения весит больше, чем в описании
+const (
+	locationWeight    = 2
+	descriptionWeight = 1
+)
+
+// termFrequency сколько раз слово встречается в полях одного наблюдения
+type termFrequency struct {
+	location    int
+	description int
+}
+
+// invertedIndex отображает слово на наблюдения, в которых оно встречается.
+// Мягко удаленные наблюдения в индекс не входят. Методы вызываются под блокировкой репозитория.
+type invertedIndex struct {
+	postings map[string]map[string]termFrequency
+	// documents количество проиндексированных наблюдений
+	documents int
+}
+
+func newInvertedIndex() *invertedIndex {
+	return &invertedIndex{
+		postings: make(map[string]map[string]termFrequency),
+	}
+}
+
+func (idx *invertedIndex) add(uuid string, info repoModel.SightingInfo) {
+	frequencies := make(map[string]termFrequency)
+	for _, term := range search.Tokenize(info.Location) {
+		tf := frequencies[term]
+		tf.location++
+		frequencies[term] = tf
+	}
+	for _, term := range search.Tokenize(info.Description) {
+		tf := frequencies[term]
+		tf.description++
+		frequencies[term] = tf
+	}
+
+	for term, tf := range frequencies {
+		posting, ok := idx.postings[term]
+		if !ok {
+			posting = make(map[string]termFrequency)
+			idx.postings[term] = posting
+		}
+		posting[uuid] = tf
+	}
+
+	idx.documents++
+}
+
+// remove убирает наблюдение из индекса; info должно совпадать с проиндексированным
+func (idx *invertedIndex) remove(uuid string, info repoModel.SightingInfo) {
+	terms := append(search.Tokenize(info.Location), search.Tokenize(info.Description)...)
+	for _, term := range terms {
+		posting, ok := idx.postings[term]
+		if !ok {
+			continue
+		}
+
+		delete(posting, uuid)
+		if len(posting) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+
+	idx.documents--
+}
+
+// search возвращает релевантность каждого наблюдения, содержащего хотя бы одно из слов.
+// Релевантность - сумма по словам взвешенной частоты в полях, умноженной на редкость слова (IDF).
+func (idx *invertedIndex) search(terms []string) map[string]float64 {
+	scores := make(map[string]float64)
+	for _, term := range terms {
+		posting := idx.postings[term]
+		if len(posting) == 0 {
+			continue
+		}
+
+		idf := math.Log(1 + float64(idx.documents)/float64(len(posting)))
+		for uuid, tf := range posting {
+			scores[uuid] += float64(locationWeight*tf.location+descriptionWeight*tf.description) * idf
+		}
+	}
+
+	return scores
+}
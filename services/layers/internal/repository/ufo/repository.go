@@ -10,12 +10,16 @@ import (
 var _ def.UFORepository = (*repository)(nil)
 
 type repository struct {
-	mu   sync.RWMutex
-	data map[string]repoModel.Sighting
+	mu     sync.RWMutex
+	data   map[string]repoModel.Sighting
+	index  *invertedIndex
+	events *broadcaster
 }
 
 func NewRepository() *repository {
 	return &repository{
-		data: make(map[string]repoModel.Sighting),
+		data:   make(map[string]repoModel.Sighting),
+		index:  newInvertedIndex(),
+		events: newBroadcaster(defaultHistorySize),
 	}
 }
@@ -0,0 +1,16 @@
+package ufo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	def "github.com/baizhigit/go-ms-examples/layers/internal/repository"
+	"github.com/baizhigit/go-ms-examples/layers/internal/repository/contract"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	suite.Run(t, contract.NewUFORepositorySuite(func(t *testing.T) def.UFORepository {
+		return NewRepository()
+	}))
+}
@@ -0,0 +1,38 @@
+package ufo
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/layers/internal/repository/converter"
+)
+
+func (r *repository) Search(_ context.Context, query model.SightingSearchQuery) ([]model.SightingSearchHit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	scores := r.index.search(query.Terms)
+
+	hits := make([]model.SightingSearchHit, 0, len(scores))
+	for uuid, score := range scores {
+		hits = append(hits, model.SightingSearchHit{
+			Sighting: repoConverter.SightingToModel(r.data[uuid]),
+			Score:    score,
+		})
+	}
+
+	slices.SortFunc(hits, func(a, b model.SightingSearchHit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Sighting.Uuid, b.Sighting.Uuid)
+	})
+
+	if len(hits) > int(query.Limit) {
+		hits = hits[:query.Limit]
+	}
+
+	return hits, nil
+}
@@ -18,6 +18,12 @@ func (r *repository) Update(_ context.Context, uuid string, updateInfo model.Sig
 		return model.ErrSightingNotFound
 	}
 
+	// Удаленное наблюдение не индексируется, иначе переиндексируем после изменения полей
+	indexed := sighting.DeletedAt == nil
+	if indexed {
+		r.index.remove(uuid, sighting.Info)
+	}
+
 	// Обновляем поля, только если они были установлены в запросе
 	if updateInfo.ObservedAt != nil {
 		sighting.Info.ObservedAt = updateInfo.ObservedAt
@@ -43,9 +49,14 @@ func (r *repository) Update(_ context.Context, uuid string, updateInfo model.Sig
 		sighting.Info.DurationSeconds = updateInfo.DurationSeconds
 	}
 
-	sighting.UpdatedAt = lo.ToPtr(time.Now())
+	now := time.Now()
+	sighting.UpdatedAt = lo.ToPtr(now)
 
 	r.data[uuid] = sighting
+	if indexed {
+		r.index.add(uuid, sighting.Info)
+	}
+	r.publish(model.SightingEventTypeUpdated, sighting, now)
 
 	return nil
 }
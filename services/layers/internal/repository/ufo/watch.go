@@ -0,0 +1,24 @@
+package ufo
+
+import (
+	"context"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	repoConverter "github.com/baizhigit/go-ms-examples/layers/internal/repository/converter"
+	repoModel "github.com/baizhigit/go-ms-examples/layers/internal/repository/model"
+)
+
+func (r *repository) Watch(_ context.Context, resumeToken string) (model.SightingEventStream, error) {
+	return r.events.subscribe(resumeToken)
+}
+
+// publish сообщает подписчикам об изменении наблюдения.
+// Вызывается под блокировкой записи, поэтому порядок событий совпадает с порядком изменений.
+func (r *repository) publish(eventType model.SightingEventType, sighting repoModel.Sighting, occurredAt time.Time) {
+	r.events.publish(model.SightingEvent{
+		Type:       eventType,
+		Sighting:   repoConverter.SightingToModel(sighting),
+		OccurredAt: occurredAt,
+	})
+}
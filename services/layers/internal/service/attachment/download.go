@@ -0,0 +1,30 @@
+package attachment
+
+import (
+	"context"
+	"io"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+)
+
+func (s *service) Download(ctx context.Context, sightingUuid, attachmentId string) (model.Attachment, io.ReadCloser, error) {
+	sighting, err := s.ufoRepository.Get(ctx, sightingUuid)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+
+	for _, attachment := range sighting.Attachments {
+		if attachment.Id != attachmentId {
+			continue
+		}
+
+		content, err := s.storage.Open(ctx, attachment.Id)
+		if err != nil {
+			return model.Attachment{}, nil, err
+		}
+
+		return attachment, content, nil
+	}
+
+	return model.Attachment{}, nil, model.ErrAttachmentNotFound
+}
@@ -0,0 +1,146 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+)
+
+const (
+	// sniffLen сколько первых байт нужно http.DetectContentType
+	sniffLen = 512
+
+	maxFileNameLen = 255
+)
+
+func (s *service) Upload(ctx context.Context, upload model.AttachmentUpload, content io.Reader) (model.Attachment, error) {
+	// Наблюдение проверяем до приема содержимого, чтобы не гонять файл впустую
+	_, err := s.ufoRepository.Get(ctx, upload.SightingUuid)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	// Тип определяем по содержимому, а не по имени файла или заявленному клиентом типу
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return model.Attachment{}, err
+	}
+	if n == 0 {
+		return model.Attachment{}, model.ErrEmptyAttachment
+	}
+	head = head[:n]
+
+	contentType := detectContentType(head)
+	if !isMedia(contentType) {
+		return model.Attachment{}, fmt.Errorf("%w: %s, only images and video are accepted",
+			model.ErrUnsupportedAttachmentType, contentType)
+	}
+
+	hash := sha256.New()
+	limited := &limitReader{
+		r:     io.MultiReader(bytes.NewReader(head), content),
+		limit: s.maxSizeBytes,
+	}
+
+	id := uuid.NewString()
+	err = s.storage.Save(ctx, id, io.TeeReader(limited, hash))
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	attachment := model.Attachment{
+		Id:          id,
+		FileName:    fileName(upload.FileName, id),
+		ContentType: contentType,
+		SizeBytes:   limited.read,
+		Sha256:      hex.EncodeToString(hash.Sum(nil)),
+		CreatedAt:   time.Now(),
+	}
+
+	_, err = s.ufoRepository.AddAttachment(ctx, upload.SightingUuid, attachment)
+	if err != nil {
+		// Наблюдение могли удалить, пока шла загрузка: содержимое без метаданных никому не доступно
+		derr := s.storage.Delete(context.WithoutCancel(ctx), id)
+		if derr != nil {
+			log.Printf("failed to delete orphaned attachment %s: %v\n", id, derr)
+		}
+		return model.Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// isoBMFFBrands типы по основному бренду ISO-BMFF (box ftyp), которые не знает
+// http.DetectContentType: в них по умолчанию снимают фото и видео на iPhone
+var isoBMFFBrands = map[string]string{
+	"heic": "image/heic",
+	"heix": "image/heic",
+	"mif1": "image/heic",
+	"qt  ": "video/quicktime",
+}
+
+// detectContentType определяет тип по первым байтам: сначала HEIC/HEIF и QuickTime по box ftyp,
+// затем http.DetectContentType
+func detectContentType(head []byte) string {
+	// Файл ISO-BMFF начинается с box ftyp: 4 байта размера, "ftyp", основной бренд
+	if len(head) >= 12 && string(head[4:8]) == "ftyp" {
+		if contentType, ok := isoBMFFBrands[string(head[8:12])]; ok {
+			return contentType
+		}
+	}
+
+	return http.DetectContentType(head)
+}
+
+// isMedia принимает изображения и видео
+func isMedia(contentType string) bool {
+	return strings.HasPrefix(contentType, "image/") || strings.HasPrefix(contentType, "video/")
+}
+
+// fileName оставляет от переданного имени только последний элемент пути,
+// без имени вложение называется по идентификатору
+func fileName(name, id string) string {
+	name = filepath.Base(strings.ReplaceAll(strings.TrimSpace(name), `\`, "/"))
+	if name == "." || name == "/" || name == "" {
+		return id
+	}
+
+	for len(name) > maxFileNameLen {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+
+	return name
+}
+
+// limitReader возвращает model.ErrAttachmentTooLarge, как только прочитано больше limit байт
+type limitReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		return n, fmt.Errorf("%w: maximum is %d bytes", model.ErrAttachmentTooLarge, l.limit)
+	}
+
+	return n, err
+}
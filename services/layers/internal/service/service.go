@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"github.com/baizhigit/go-ms-examples/layers/internal/model"
 )
@@ -11,4 +12,13 @@ type UFOService interface {
 	Get(ctx context.Context, uuid string) (model.Sighting, error)
 	Update(ctx context.Context, uuid string, updateInfo model.SightingUpdateInfo) error
 	Delete(ctx context.Context, uuid string) error
+	Watch(ctx context.Context, resumeToken string) (model.SightingEventStream, error)
+	Search(ctx context.Context, text string, limit int32) ([]model.SightingSearchHit, error)
+	GetStats(ctx context.Context, query model.StatsQuery) (model.Stats, error)
+}
+
+type AttachmentService interface {
+	Upload(ctx context.Context, upload model.AttachmentUpload, content io.Reader) (model.Attachment, error)
+	// Download возвращает метаданные и содержимое вложения, содержимое закрывает вызывающий
+	Download(ctx context.Context, sightingUuid, attachmentId string) (model.Attachment, io.ReadCloser, error)
 }
@@ -0,0 +1,66 @@
+package ufo
+
+import (
+	"context"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	"github.com/baizhigit/go-ms-examples/layers/internal/search"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+func (s *service) Search(ctx context.Context, text string, limit int32) ([]model.SightingSearchHit, error) {
+	terms := search.Terms(text)
+	if len(terms) == 0 {
+		return nil, model.ErrEmptySearchQuery
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultSearchLimit
+	case limit > maxSearchLimit:
+		limit = maxSearchLimit
+	}
+
+	hits, err := s.ufoRepository.Search(ctx, model.SightingSearchQuery{
+		Terms: terms,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range hits {
+		hits[i].Highlights = highlights(hits[i].Sighting.Info, terms)
+	}
+
+	return hits, nil
+}
+
+func highlights(info model.SightingInfo, terms []string) []model.SearchHighlight {
+	var result []model.SearchHighlight
+
+	fields := []struct {
+		name string
+		text string
+	}{
+		{name: "location", text: info.Location},
+		{name: "description", text: info.Description},
+	}
+	for _, field := range fields {
+		fragment, ok := search.Highlight(field.text, terms)
+		if !ok {
+			continue
+		}
+
+		result = append(result, model.SearchHighlight{
+			Field:    field.name,
+			Fragment: fragment,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,106 @@
+package ufo
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+	"github.com/baizhigit/go-ms-examples/layers/internal/stats"
+)
+
+// maxStatsBuckets сколько интервалов может быть в ряду группировки по времени,
+// больше на графике все равно не разобрать
+const maxStatsBuckets = 1000
+
+func (s *service) GetStats(ctx context.Context, query model.StatsQuery) (model.Stats, error) {
+	if query.GroupBy <= model.StatsGroupByUnspecified || query.GroupBy > model.StatsGroupBySound {
+		return model.Stats{}, model.ErrInvalidStatsGroupBy
+	}
+
+	if query.ObservedFrom != nil && query.ObservedTo != nil && !query.ObservedFrom.Before(*query.ObservedTo) {
+		return model.Stats{}, fmt.Errorf("%w: observed_from must be before observed_to", model.ErrInvalidStatsRange)
+	}
+
+	buckets, err := s.ufoRepository.Stats(ctx, query)
+	if err != nil {
+		return model.Stats{}, err
+	}
+
+	if query.GroupBy.IsTime() {
+		buckets, err = timeSeries(buckets, query)
+		if err != nil {
+			return model.Stats{}, err
+		}
+	} else {
+		slices.SortFunc(buckets, func(a, b model.StatsBucket) int {
+			if c := cmp.Compare(b.Count, a.Count); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.Key, b.Key)
+		})
+	}
+
+	result := model.Stats{
+		GroupBy: query.GroupBy,
+		Buckets: buckets,
+	}
+	for _, bucket := range buckets {
+		result.Total += bucket.Count
+	}
+
+	return result, nil
+}
+
+// timeSeries превращает группы по времени в непрерывный ряд: интервалы без наблюдений
+// получают нулевое количество, чтобы ряд можно было сразу рисовать на графике.
+// Ряд покрывает запрошенный период, а если граница не задана - период от первой до последней группы.
+func timeSeries(buckets []model.StatsBucket, query model.StatsQuery) ([]model.StatsBucket, error) {
+	counts := make(map[time.Time]int64, len(buckets))
+	var first, last time.Time
+	for _, bucket := range buckets {
+		if bucket.Start == nil {
+			continue
+		}
+
+		start := bucket.Start.UTC()
+		counts[start] += bucket.Count
+		if first.IsZero() || start.Before(first) {
+			first = start
+		}
+		if last.IsZero() || start.After(last) {
+			last = start
+		}
+	}
+
+	if query.ObservedFrom != nil {
+		first = stats.Truncate(*query.ObservedFrom, query.GroupBy)
+	}
+	if query.ObservedTo != nil {
+		// Граница не включается, последний интервал тот, в который попадает момент перед ней
+		last = stats.Truncate(query.ObservedTo.Add(-time.Nanosecond), query.GroupBy)
+	}
+
+	if len(counts) == 0 && (query.ObservedFrom == nil || query.ObservedTo == nil) {
+		return nil, nil
+	}
+
+	series := make([]model.StatsBucket, 0, len(counts))
+	for start := first; !start.After(last); start = stats.Next(start, query.GroupBy) {
+		if len(series) == maxStatsBuckets {
+			return nil, fmt.Errorf("%w: more than %d intervals, narrow the period or use a coarser grouping",
+				model.ErrStatsRangeTooLarge, maxStatsBuckets)
+		}
+
+		bucketStart := start
+		series = append(series, model.StatsBucket{
+			Key:   stats.Key(start, query.GroupBy),
+			Start: &bucketStart,
+			Count: counts[start],
+		})
+	}
+
+	return series, nil
+}
@@ -0,0 +1,16 @@
+package ufo
+
+import (
+	"context"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+)
+
+func (s *service) Watch(ctx context.Context, resumeToken string) (model.SightingEventStream, error) {
+	stream, err := s.ufoRepository.Watch(ctx, resumeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
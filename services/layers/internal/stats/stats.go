@@ -0,0 +1,70 @@
+// Package stats описывает интервалы и подписи групп статистики наблюдений: репозиторий по ним
+// группирует, сервис достраивает ряд. Время группируется в UTC, неделя начинается с понедельника (ISO 8601).
+package stats
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/baizhigit/go-ms-examples/layers/internal/model"
+)
+
+// Truncate возвращает начало интервала группировки, в который попадает t
+func Truncate(t time.Time, groupBy model.StatsGroupBy) time.Time {
+	year, month, day := t.UTC().Date()
+
+	switch groupBy {
+	case model.StatsGroupByWeek:
+		// Weekday считает с воскресенья, сдвигаем так, чтобы понедельник был нулевым днем
+		offset := (int(t.UTC().Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, time.UTC)
+	case model.StatsGroupByMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Next возвращает начало интервала, следующего за интервалом с началом start
+func Next(start time.Time, groupBy model.StatsGroupBy) time.Time {
+	switch groupBy {
+	case model.StatsGroupByWeek:
+		return start.AddDate(0, 0, 7)
+	case model.StatsGroupByMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Key возвращает подпись интервала: 2024-06-15, 2024-W24 или 2024-06
+func Key(start time.Time, groupBy model.StatsGroupBy) string {
+	switch groupBy {
+	case model.StatsGroupByWeek:
+		year, week := start.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", year, week)
+	case model.StatsGroupByMonth:
+		return start.Format("2006-01")
+	default:
+		return start.Format(time.DateOnly)
+	}
+}
+
+// ColorKey подпись группы по цвету, пустая для наблюдений без цвета
+func ColorKey(color *string) string {
+	if color == nil {
+		return ""
+	}
+
+	return *color
+}
+
+// SoundKey подпись группы по звуку: true, false или пустая строка, если признак не указан
+func SoundKey(sound *bool) string {
+	if sound == nil {
+		return ""
+	}
+
+	return strconv.FormatBool(*sound)
+}
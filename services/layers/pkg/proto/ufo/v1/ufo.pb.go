@@ -26,6 +26,128 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// SightingEventType тип изменения наблюдения
+type SightingEventType int32
+
+const (
+	// SIGHTING_EVENT_TYPE_UNSPECIFIED тип не указан
+	SightingEventType_SIGHTING_EVENT_TYPE_UNSPECIFIED SightingEventType = 0
+	// SIGHTING_EVENT_TYPE_CREATED наблюдение создано
+	SightingEventType_SIGHTING_EVENT_TYPE_CREATED SightingEventType = 1
+	// SIGHTING_EVENT_TYPE_UPDATED наблюдение изменено
+	SightingEventType_SIGHTING_EVENT_TYPE_UPDATED SightingEventType = 2
+	// SIGHTING_EVENT_TYPE_DELETED наблюдение мягко удалено
+	SightingEventType_SIGHTING_EVENT_TYPE_DELETED SightingEventType = 3
+)
+
+// Enum value maps for SightingEventType.
+var (
+	SightingEventType_name = map[int32]string{
+		0: "SIGHTING_EVENT_TYPE_UNSPECIFIED",
+		1: "SIGHTING_EVENT_TYPE_CREATED",
+		2: "SIGHTING_EVENT_TYPE_UPDATED",
+		3: "SIGHTING_EVENT_TYPE_DELETED",
+	}
+	SightingEventType_value = map[string]int32{
+		"SIGHTING_EVENT_TYPE_UNSPECIFIED": 0,
+		"SIGHTING_EVENT_TYPE_CREATED":     1,
+		"SIGHTING_EVENT_TYPE_UPDATED":     2,
+		"SIGHTING_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x SightingEventType) Enum() *SightingEventType {
+	p := new(SightingEventType)
+	*p = x
+	return p
+}
+
+func (x SightingEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SightingEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v1_ufo_proto_enumTypes[0].Descriptor()
+}
+
+func (SightingEventType) Type() protoreflect.EnumType {
+	return &file_ufo_v1_ufo_proto_enumTypes[0]
+}
+
+func (x SightingEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SightingEventType.Descriptor instead.
+func (SightingEventType) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{0}
+}
+
+// StatsGroupBy измерение, по которому группируется статистика
+type StatsGroupBy int32
+
+const (
+	// STATS_GROUP_BY_UNSPECIFIED измерение не указано
+	StatsGroupBy_STATS_GROUP_BY_UNSPECIFIED StatsGroupBy = 0
+	// STATS_GROUP_BY_DAY по дням наблюдения (UTC)
+	StatsGroupBy_STATS_GROUP_BY_DAY StatsGroupBy = 1
+	// STATS_GROUP_BY_WEEK по неделям наблюдения (ISO, с понедельника, UTC)
+	StatsGroupBy_STATS_GROUP_BY_WEEK StatsGroupBy = 2
+	// STATS_GROUP_BY_MONTH по месяцам наблюдения (UTC)
+	StatsGroupBy_STATS_GROUP_BY_MONTH StatsGroupBy = 3
+	// STATS_GROUP_BY_COLOR по цвету объекта
+	StatsGroupBy_STATS_GROUP_BY_COLOR StatsGroupBy = 4
+	// STATS_GROUP_BY_SOUND по наличию звука
+	StatsGroupBy_STATS_GROUP_BY_SOUND StatsGroupBy = 5
+)
+
+// Enum value maps for StatsGroupBy.
+var (
+	StatsGroupBy_name = map[int32]string{
+		0: "STATS_GROUP_BY_UNSPECIFIED",
+		1: "STATS_GROUP_BY_DAY",
+		2: "STATS_GROUP_BY_WEEK",
+		3: "STATS_GROUP_BY_MONTH",
+		4: "STATS_GROUP_BY_COLOR",
+		5: "STATS_GROUP_BY_SOUND",
+	}
+	StatsGroupBy_value = map[string]int32{
+		"STATS_GROUP_BY_UNSPECIFIED": 0,
+		"STATS_GROUP_BY_DAY":         1,
+		"STATS_GROUP_BY_WEEK":        2,
+		"STATS_GROUP_BY_MONTH":       3,
+		"STATS_GROUP_BY_COLOR":       4,
+		"STATS_GROUP_BY_SOUND":       5,
+	}
+)
+
+func (x StatsGroupBy) Enum() *StatsGroupBy {
+	p := new(StatsGroupBy)
+	*p = x
+	return p
+}
+
+func (x StatsGroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsGroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v1_ufo_proto_enumTypes[1].Descriptor()
+}
+
+func (StatsGroupBy) Type() protoreflect.EnumType {
+	return &file_ufo_v1_ufo_proto_enumTypes[1]
+}
+
+func (x StatsGroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsGroupBy.Descriptor instead.
+func (StatsGroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{1}
+}
+
 // SightingInfo базовая информация о наблюдении НЛО
 type SightingInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -220,7 +342,9 @@ type Sighting struct {
 	// updated_at время последнего обновления записи
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// deleted_at время удаления записи (опционально)
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// attachments вложения наблюдения в порядке загрузки
+	Attachments   []*Attachment `protobuf:"bytes,6,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -290,6 +414,104 @@ func (x *Sighting) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Sighting) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Attachment метаданные вложения наблюдения
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id идентификатор вложения
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// file_name имя файла, переданное при загрузке
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// content_type тип содержимого, определенный по первым байтам файла
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// size_bytes размер содержимого в байтах
+	SizeBytes int64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// sha256 контрольная сумма содержимого SHA-256 в шестнадцатеричном виде
+	Sha256 string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// created_at время загрузки
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 // CreateRequest запрос на создание наблюдения НЛО
 type CreateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -301,7 +523,7 @@ type CreateRequest struct {
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +535,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +548,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{3}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateRequest) GetInfo() *SightingInfo {
@@ -347,7 +569,7 @@ type CreateResponse struct {
 
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +581,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +594,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{4}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateResponse) GetUuid() string {
@@ -393,7 +615,7 @@ type GetRequest struct {
 
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +627,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +640,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{5}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetRequest) GetUuid() string {
@@ -439,7 +661,7 @@ type GetResponse struct {
 
 func (x *GetResponse) Reset() {
 	*x = GetResponse{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -451,7 +673,7 @@ func (x *GetResponse) String() string {
 func (*GetResponse) ProtoMessage() {}
 
 func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +686,7 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{6}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetResponse) GetSighting() *Sighting {
@@ -487,7 +709,7 @@ type UpdateRequest struct {
 
 func (x *UpdateRequest) Reset() {
 	*x = UpdateRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +721,7 @@ func (x *UpdateRequest) String() string {
 func (*UpdateRequest) ProtoMessage() {}
 
 func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +734,7 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{7}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateRequest) GetUuid() string {
@@ -540,7 +762,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +774,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +787,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{8}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteRequest) GetUuid() string {
@@ -575,137 +797,1143 @@ func (x *DeleteRequest) GetUuid() string {
 	return ""
 }
 
-var File_ufo_v1_ufo_proto protoreflect.FileDescriptor
+// WatchSightingsRequest запрос на подписку на события наблюдений
+type WatchSightingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resume_token токен последнего полученного события (пустой - только новые события)
+	ResumeToken   string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_ufo_v1_ufo_proto_rawDesc = "" +
-	"\n" +
-	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xb7\x02\n" +
-	"\fSightingInfo\x12;\n" +
-	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"observedAt\x12\x1a\n" +
-	"\blocation\x18\x02 \x01(\tR\blocation\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
-	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
-	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x02\n" +
-	"\x12SightingUpdateInfo\x12;\n" +
-	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"observedAt\x128\n" +
-	"\blocation\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12>\n" +
-	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x122\n" +
-	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
-	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x01\n" +
-	"\bSighting\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12(\n" +
-	"\x04info\x18\x02 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\x129\n" +
-	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
-	"\n" +
-	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"9\n" +
-	"\rCreateRequest\x12(\n" +
-	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\"$\n" +
-	"\x0eCreateResponse\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\" \n" +
-	"\n" +
-	"GetRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
-	"\vGetResponse\x12,\n" +
-	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"`\n" +
-	"\rUpdateRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12;\n" +
-	"\vupdate_info\x18\x02 \x01(\v2\x1a.ufo.v1.SightingUpdateInfoR\n" +
-	"updateInfo\"#\n" +
-	"\rDeleteRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid2\xe7\x01\n" +
-	"\n" +
-	"UFOService\x127\n" +
-	"\x06Create\x12\x15.ufo.v1.CreateRequest\x1a\x16.ufo.v1.CreateResponse\x12.\n" +
-	"\x03Get\x12\x12.ufo.v1.GetRequest\x1a\x13.ufo.v1.GetResponse\x127\n" +
-	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\x127\n" +
-	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.EmptyBDZBgithub.com/baizhigit/go-ms-examples/layers/pkg/proto/ufo/v1;ufo_v1b\x06proto3"
+func (x *WatchSightingsRequest) Reset() {
+	*x = WatchSightingsRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_ufo_v1_ufo_proto_rawDescOnce sync.Once
-	file_ufo_v1_ufo_proto_rawDescData []byte
-)
+func (x *WatchSightingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_ufo_v1_ufo_proto_rawDescGZIP() []byte {
-	file_ufo_v1_ufo_proto_rawDescOnce.Do(func() {
-		file_ufo_v1_ufo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ufo_v1_ufo_proto_rawDesc), len(file_ufo_v1_ufo_proto_rawDesc)))
-	})
-	return file_ufo_v1_ufo_proto_rawDescData
+func (*WatchSightingsRequest) ProtoMessage() {}
+
+func (x *WatchSightingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_ufo_v1_ufo_proto_goTypes = []any{
-	(*SightingInfo)(nil),           // 0: ufo.v1.SightingInfo
-	(*SightingUpdateInfo)(nil),     // 1: ufo.v1.SightingUpdateInfo
-	(*Sighting)(nil),               // 2: ufo.v1.Sighting
-	(*CreateRequest)(nil),          // 3: ufo.v1.CreateRequest
-	(*CreateResponse)(nil),         // 4: ufo.v1.CreateResponse
-	(*GetRequest)(nil),             // 5: ufo.v1.GetRequest
-	(*GetResponse)(nil),            // 6: ufo.v1.GetResponse
-	(*UpdateRequest)(nil),          // 7: ufo.v1.UpdateRequest
-	(*DeleteRequest)(nil),          // 8: ufo.v1.DeleteRequest
-	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil), // 10: google.protobuf.StringValue
-	(*wrapperspb.BoolValue)(nil),   // 11: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),  // 12: google.protobuf.Int32Value
-	(*emptypb.Empty)(nil),          // 13: google.protobuf.Empty
+// Deprecated: Use WatchSightingsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSightingsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{10}
 }
-var file_ufo_v1_ufo_proto_depIdxs = []int32{
-	9,  // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
-	10, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
-	11, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
-	12, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
-	9,  // 4: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
-	10, // 5: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
-	10, // 6: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
-	10, // 7: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
-	11, // 8: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
-	12, // 9: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
-	0,  // 10: ufo.v1.Sighting.info:type_name -> ufo.v1.SightingInfo
-	9,  // 11: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 12: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 13: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
-	0,  // 14: ufo.v1.CreateRequest.info:type_name -> ufo.v1.SightingInfo
-	2,  // 15: ufo.v1.GetResponse.sighting:type_name -> ufo.v1.Sighting
-	1,  // 16: ufo.v1.UpdateRequest.update_info:type_name -> ufo.v1.SightingUpdateInfo
-	3,  // 17: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
-	5,  // 18: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
-	7,  // 19: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
-	8,  // 20: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
-	4,  // 21: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
-	6,  // 22: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
-	13, // 23: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
-	13, // 24: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
-	21, // [21:25] is the sub-list for method output_type
-	17, // [17:21] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+
+func (x *WatchSightingsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
 }
 
-func init() { file_ufo_v1_ufo_proto_init() }
-func file_ufo_v1_ufo_proto_init() {
-	if File_ufo_v1_ufo_proto != nil {
-		return
+// SightingEvent событие изменения наблюдения
+type SightingEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type тип изменения
+	Type SightingEventType `protobuf:"varint,1,opt,name=type,proto3,enum=ufo.v1.SightingEventType" json:"type,omitempty"`
+	// uuid идентификатор измененного наблюдения
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// sighting состояние наблюдения после изменения
+	Sighting *Sighting `protobuf:"bytes,3,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	// occurred_at время изменения
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// resume_token токен для продолжения подписки сразу после этого события
+	ResumeToken   string `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SightingEvent) Reset() {
+	*x = SightingEvent{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SightingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SightingEvent) ProtoMessage() {}
+
+func (x *SightingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SightingEvent.ProtoReflect.Descriptor instead.
+func (*SightingEvent) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SightingEvent) GetType() SightingEventType {
+	if x != nil {
+		return x.Type
+	}
+	return SightingEventType_SIGHTING_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *SightingEvent) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SightingEvent) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+func (x *SightingEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *SightingEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// SearchRequest запрос полнотекстового поиска
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query слова для поиска, наблюдение подходит, если содержит любое из них (регистр не учитывается)
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit максимальное количество результатов
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchHighlight фрагмент поля, в котором найденные слова обернуты в <em>...</em>
+type SearchHighlight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field имя поля: location или description
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// fragment текст поля (для длинного поля - фрагмент вокруг первого совпадения)
+	Fragment      string `protobuf:"bytes,2,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetFragment() string {
+	if x != nil {
+		return x.Fragment
+	}
+	return ""
+}
+
+// SearchHit найденное наблюдение
+type SearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting данные наблюдения
+	Sighting *Sighting `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	// score релевантность; сравнима только внутри одного ответа
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// highlights подсвеченные совпадения по полям
+	Highlights    []*SearchHighlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchHit) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// SearchResponse результаты поиска в порядке убывания релевантности
+type SearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hits найденные наблюдения (мягко удаленные не ищутся)
+	Hits          []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// GetStatsRequest запрос статистики наблюдений
+type GetStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// observed_from начало периода по времени наблюдения, включительно
+	ObservedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_from,json=observedFrom,proto3" json:"observed_from,omitempty"`
+	// observed_to конец периода по времени наблюдения, не включительно
+	ObservedTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=observed_to,json=observedTo,proto3" json:"observed_to,omitempty"`
+	// group_by измерение группировки
+	GroupBy       StatsGroupBy `protobuf:"varint,3,opt,name=group_by,json=groupBy,proto3,enum=ufo.v1.StatsGroupBy" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetStatsRequest) GetObservedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedFrom
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetObservedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedTo
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetGroupBy() StatsGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return StatsGroupBy_STATS_GROUP_BY_UNSPECIFIED
+}
+
+// StatsBucket количество наблюдений в одной группе
+type StatsBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key подпись группы: 2024-06-15, 2024-W24, 2024-06, цвет или true/false;
+	// пустая строка для наблюдений без цвета или без признака звука
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// start начало интервала для группировки по времени
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// count количество наблюдений
+	Count         int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsBucket) Reset() {
+	*x = StatsBucket{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsBucket) ProtoMessage() {}
+
+func (x *StatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsBucket.ProtoReflect.Descriptor instead.
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StatsBucket) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatsBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *StatsBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetStatsResponse статистика наблюдений (мягко удаленные не учитываются)
+type GetStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// group_by измерение группировки
+	GroupBy StatsGroupBy `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=ufo.v1.StatsGroupBy" json:"group_by,omitempty"`
+	// buckets группы; по времени - непрерывный ряд по возрастанию с нулями в пустых интервалах,
+	// по цвету и звуку - по убыванию количества
+	Buckets []*StatsBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// total количество наблюдений во всех группах
+	Total         int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetStatsResponse) GetGroupBy() StatsGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return StatsGroupBy_STATS_GROUP_BY_UNSPECIFIED
+}
+
+func (x *GetStatsResponse) GetBuckets() []*StatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// UploadAttachmentMetadata описание загружаемого вложения
+type UploadAttachmentMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting_uuid наблюдение, к которому относится вложение
+	SightingUuid string `protobuf:"bytes,1,opt,name=sighting_uuid,json=sightingUuid,proto3" json:"sighting_uuid,omitempty"`
+	// file_name имя файла
+	FileName      string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentMetadata) Reset() {
+	*x = UploadAttachmentMetadata{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentMetadata) ProtoMessage() {}
+
+func (x *UploadAttachmentMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentMetadata.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentMetadata) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UploadAttachmentMetadata) GetSightingUuid() string {
+	if x != nil {
+		return x.SightingUuid
+	}
+	return ""
+}
+
+func (x *UploadAttachmentMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// UploadAttachmentRequest одно сообщение потока загрузки вложения
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Metadata
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetMetadata() *UploadAttachmentMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Metadata struct {
+	// metadata описание вложения, только в первом сообщении
+	Metadata *UploadAttachmentMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	// chunk очередная часть содержимого файла
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Metadata) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+// UploadAttachmentResponse результат загрузки вложения
+type UploadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attachment метаданные сохраненного вложения
+	Attachment    *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// DownloadAttachmentRequest запрос вложения наблюдения
+type DownloadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting_uuid наблюдение, к которому относится вложение
+	SightingUuid string `protobuf:"bytes,1,opt,name=sighting_uuid,json=sightingUuid,proto3" json:"sighting_uuid,omitempty"`
+	// attachment_id идентификатор вложения
+	AttachmentId  string `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DownloadAttachmentRequest) GetSightingUuid() string {
+	if x != nil {
+		return x.SightingUuid
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+// DownloadAttachmentResponse одно сообщение потока выгрузки вложения
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	// attachment метаданные вложения, только в первом сообщении
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	// chunk очередная часть содержимого файла
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+var File_ufo_v1_ufo_proto protoreflect.FileDescriptor
+
+const file_ufo_v1_ufo_proto_rawDesc = "" +
+	"\n" +
+	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xb7\x02\n" +
+	"\fSightingInfo\x12;\n" +
+	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedAt\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x02\n" +
+	"\x12SightingUpdateInfo\x12;\n" +
+	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedAt\x128\n" +
+	"\blocation\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12>\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x122\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xaf\x02\n" +
+	"\bSighting\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12(\n" +
+	"\x04info\x18\x02 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x124\n" +
+	"\vattachments\x18\x06 \x03(\v2\x12.ufo.v1.AttachmentR\vattachments\"\xce\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"9\n" +
+	"\rCreateRequest\x12(\n" +
+	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\"$\n" +
+	"\x0eCreateResponse\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\" \n" +
+	"\n" +
+	"GetRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
+	"\vGetResponse\x12,\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"`\n" +
+	"\rUpdateRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12;\n" +
+	"\vupdate_info\x18\x02 \x01(\v2\x1a.ufo.v1.SightingUpdateInfoR\n" +
+	"updateInfo\"#\n" +
+	"\rDeleteRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\":\n" +
+	"\x15WatchSightingsRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\"\xe0\x01\n" +
+	"\rSightingEvent\x12-\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x19.ufo.v1.SightingEventTypeR\x04type\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12,\n" +
+	"\bsighting\x18\x03 \x01(\v2\x10.ufo.v1.SightingR\bsighting\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\";\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"C\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\bfragment\x18\x02 \x01(\tR\bfragment\"\x88\x01\n" +
+	"\tSearchHit\x12,\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x127\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x17.ufo.v1.SearchHighlightR\n" +
+	"highlights\"7\n" +
+	"\x0eSearchResponse\x12%\n" +
+	"\x04hits\x18\x01 \x03(\v2\x11.ufo.v1.SearchHitR\x04hits\"\xc0\x01\n" +
+	"\x0fGetStatsRequest\x12?\n" +
+	"\robserved_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fobservedFrom\x12;\n" +
+	"\vobserved_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedTo\x12/\n" +
+	"\bgroup_by\x18\x03 \x01(\x0e2\x14.ufo.v1.StatsGroupByR\agroupBy\"g\n" +
+	"\vStatsBucket\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\x88\x01\n" +
+	"\x10GetStatsResponse\x12/\n" +
+	"\bgroup_by\x18\x01 \x01(\x0e2\x14.ufo.v1.StatsGroupByR\agroupBy\x12-\n" +
+	"\abuckets\x18\x02 \x03(\v2\x13.ufo.v1.StatsBucketR\abuckets\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"\\\n" +
+	"\x18UploadAttachmentMetadata\x12#\n" +
+	"\rsighting_uuid\x18\x01 \x01(\tR\fsightingUuid\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\"|\n" +
+	"\x17UploadAttachmentRequest\x12>\n" +
+	"\bmetadata\x18\x01 \x01(\v2 .ufo.v1.UploadAttachmentMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"N\n" +
+	"\x18UploadAttachmentResponse\x122\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x12.ufo.v1.AttachmentR\n" +
+	"attachment\"e\n" +
+	"\x19DownloadAttachmentRequest\x12#\n" +
+	"\rsighting_uuid\x18\x01 \x01(\tR\fsightingUuid\x12#\n" +
+	"\rattachment_id\x18\x02 \x01(\tR\fattachmentId\"u\n" +
+	"\x1aDownloadAttachmentResponse\x124\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x12.ufo.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload*\x9b\x01\n" +
+	"\x11SightingEventType\x12#\n" +
+	"\x1fSIGHTING_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSIGHTING_EVENT_TYPE_CREATED\x10\x01\x12\x1f\n" +
+	"\x1bSIGHTING_EVENT_TYPE_UPDATED\x10\x02\x12\x1f\n" +
+	"\x1bSIGHTING_EVENT_TYPE_DELETED\x10\x03*\xad\x01\n" +
+	"\fStatsGroupBy\x12\x1e\n" +
+	"\x1aSTATS_GROUP_BY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12STATS_GROUP_BY_DAY\x10\x01\x12\x17\n" +
+	"\x13STATS_GROUP_BY_WEEK\x10\x02\x12\x18\n" +
+	"\x14STATS_GROUP_BY_MONTH\x10\x03\x12\x18\n" +
+	"\x14STATS_GROUP_BY_COLOR\x10\x04\x12\x18\n" +
+	"\x14STATS_GROUP_BY_SOUND\x10\x052\xe1\x04\n" +
+	"\n" +
+	"UFOService\x127\n" +
+	"\x06Create\x12\x15.ufo.v1.CreateRequest\x1a\x16.ufo.v1.CreateResponse\x12.\n" +
+	"\x03Get\x12\x12.ufo.v1.GetRequest\x1a\x13.ufo.v1.GetResponse\x127\n" +
+	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\x127\n" +
+	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x0eWatchSightings\x12\x1d.ufo.v1.WatchSightingsRequest\x1a\x15.ufo.v1.SightingEvent0\x01\x127\n" +
+	"\x06Search\x12\x15.ufo.v1.SearchRequest\x1a\x16.ufo.v1.SearchResponse\x12=\n" +
+	"\bGetStats\x12\x17.ufo.v1.GetStatsRequest\x1a\x18.ufo.v1.GetStatsResponse\x12W\n" +
+	"\x10UploadAttachment\x12\x1f.ufo.v1.UploadAttachmentRequest\x1a .ufo.v1.UploadAttachmentResponse(\x01\x12]\n" +
+	"\x12DownloadAttachment\x12!.ufo.v1.DownloadAttachmentRequest\x1a\".ufo.v1.DownloadAttachmentResponse0\x01BDZBgithub.com/baizhigit/go-ms-examples/layers/pkg/proto/ufo/v1;ufo_v1b\x06proto3"
+
+var (
+	file_ufo_v1_ufo_proto_rawDescOnce sync.Once
+	file_ufo_v1_ufo_proto_rawDescData []byte
+)
+
+func file_ufo_v1_ufo_proto_rawDescGZIP() []byte {
+	file_ufo_v1_ufo_proto_rawDescOnce.Do(func() {
+		file_ufo_v1_ufo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ufo_v1_ufo_proto_rawDesc), len(file_ufo_v1_ufo_proto_rawDesc)))
+	})
+	return file_ufo_v1_ufo_proto_rawDescData
+}
+
+var file_ufo_v1_ufo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_ufo_v1_ufo_proto_goTypes = []any{
+	(SightingEventType)(0),             // 0: ufo.v1.SightingEventType
+	(StatsGroupBy)(0),                  // 1: ufo.v1.StatsGroupBy
+	(*SightingInfo)(nil),               // 2: ufo.v1.SightingInfo
+	(*SightingUpdateInfo)(nil),         // 3: ufo.v1.SightingUpdateInfo
+	(*Sighting)(nil),                   // 4: ufo.v1.Sighting
+	(*Attachment)(nil),                 // 5: ufo.v1.Attachment
+	(*CreateRequest)(nil),              // 6: ufo.v1.CreateRequest
+	(*CreateResponse)(nil),             // 7: ufo.v1.CreateResponse
+	(*GetRequest)(nil),                 // 8: ufo.v1.GetRequest
+	(*GetResponse)(nil),                // 9: ufo.v1.GetResponse
+	(*UpdateRequest)(nil),              // 10: ufo.v1.UpdateRequest
+	(*DeleteRequest)(nil),              // 11: ufo.v1.DeleteRequest
+	(*WatchSightingsRequest)(nil),      // 12: ufo.v1.WatchSightingsRequest
+	(*SightingEvent)(nil),              // 13: ufo.v1.SightingEvent
+	(*SearchRequest)(nil),              // 14: ufo.v1.SearchRequest
+	(*SearchHighlight)(nil),            // 15: ufo.v1.SearchHighlight
+	(*SearchHit)(nil),                  // 16: ufo.v1.SearchHit
+	(*SearchResponse)(nil),             // 17: ufo.v1.SearchResponse
+	(*GetStatsRequest)(nil),            // 18: ufo.v1.GetStatsRequest
+	(*StatsBucket)(nil),                // 19: ufo.v1.StatsBucket
+	(*GetStatsResponse)(nil),           // 20: ufo.v1.GetStatsResponse
+	(*UploadAttachmentMetadata)(nil),   // 21: ufo.v1.UploadAttachmentMetadata
+	(*UploadAttachmentRequest)(nil),    // 22: ufo.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),   // 23: ufo.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),  // 24: ufo.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil), // 25: ufo.v1.DownloadAttachmentResponse
+	(*timestamppb.Timestamp)(nil),      // 26: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),     // 27: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),       // 28: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),      // 29: google.protobuf.Int32Value
+	(*emptypb.Empty)(nil),              // 30: google.protobuf.Empty
+}
+var file_ufo_v1_ufo_proto_depIdxs = []int32{
+	26, // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
+	27, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
+	28, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
+	29, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	26, // 4: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
+	27, // 5: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
+	27, // 6: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
+	27, // 7: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
+	28, // 8: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
+	29, // 9: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	2,  // 10: ufo.v1.Sighting.info:type_name -> ufo.v1.SightingInfo
+	26, // 11: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
+	26, // 12: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 13: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
+	5,  // 14: ufo.v1.Sighting.attachments:type_name -> ufo.v1.Attachment
+	26, // 15: ufo.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 16: ufo.v1.CreateRequest.info:type_name -> ufo.v1.SightingInfo
+	4,  // 17: ufo.v1.GetResponse.sighting:type_name -> ufo.v1.Sighting
+	3,  // 18: ufo.v1.UpdateRequest.update_info:type_name -> ufo.v1.SightingUpdateInfo
+	0,  // 19: ufo.v1.SightingEvent.type:type_name -> ufo.v1.SightingEventType
+	4,  // 20: ufo.v1.SightingEvent.sighting:type_name -> ufo.v1.Sighting
+	26, // 21: ufo.v1.SightingEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	4,  // 22: ufo.v1.SearchHit.sighting:type_name -> ufo.v1.Sighting
+	15, // 23: ufo.v1.SearchHit.highlights:type_name -> ufo.v1.SearchHighlight
+	16, // 24: ufo.v1.SearchResponse.hits:type_name -> ufo.v1.SearchHit
+	26, // 25: ufo.v1.GetStatsRequest.observed_from:type_name -> google.protobuf.Timestamp
+	26, // 26: ufo.v1.GetStatsRequest.observed_to:type_name -> google.protobuf.Timestamp
+	1,  // 27: ufo.v1.GetStatsRequest.group_by:type_name -> ufo.v1.StatsGroupBy
+	26, // 28: ufo.v1.StatsBucket.start:type_name -> google.protobuf.Timestamp
+	1,  // 29: ufo.v1.GetStatsResponse.group_by:type_name -> ufo.v1.StatsGroupBy
+	19, // 30: ufo.v1.GetStatsResponse.buckets:type_name -> ufo.v1.StatsBucket
+	21, // 31: ufo.v1.UploadAttachmentRequest.metadata:type_name -> ufo.v1.UploadAttachmentMetadata
+	5,  // 32: ufo.v1.UploadAttachmentResponse.attachment:type_name -> ufo.v1.Attachment
+	5,  // 33: ufo.v1.DownloadAttachmentResponse.attachment:type_name -> ufo.v1.Attachment
+	6,  // 34: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
+	8,  // 35: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
+	10, // 36: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
+	11, // 37: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
+	12, // 38: ufo.v1.UFOService.WatchSightings:input_type -> ufo.v1.WatchSightingsRequest
+	14, // 39: ufo.v1.UFOService.Search:input_type -> ufo.v1.SearchRequest
+	18, // 40: ufo.v1.UFOService.GetStats:input_type -> ufo.v1.GetStatsRequest
+	22, // 41: ufo.v1.UFOService.UploadAttachment:input_type -> ufo.v1.UploadAttachmentRequest
+	24, // 42: ufo.v1.UFOService.DownloadAttachment:input_type -> ufo.v1.DownloadAttachmentRequest
+	7,  // 43: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
+	9,  // 44: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
+	30, // 45: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
+	30, // 46: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
+	13, // 47: ufo.v1.UFOService.WatchSightings:output_type -> ufo.v1.SightingEvent
+	17, // 48: ufo.v1.UFOService.Search:output_type -> ufo.v1.SearchResponse
+	20, // 49: ufo.v1.UFOService.GetStats:output_type -> ufo.v1.GetStatsResponse
+	23, // 50: ufo.v1.UFOService.UploadAttachment:output_type -> ufo.v1.UploadAttachmentResponse
+	25, // 51: ufo.v1.UFOService.DownloadAttachment:output_type -> ufo.v1.DownloadAttachmentResponse
+	43, // [43:52] is the sub-list for method output_type
+	34, // [34:43] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_ufo_v1_ufo_proto_init() }
+func file_ufo_v1_ufo_proto_init() {
+	if File_ufo_v1_ufo_proto != nil {
+		return
+	}
+	file_ufo_v1_ufo_proto_msgTypes[20].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Metadata)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_ufo_v1_ufo_proto_msgTypes[23].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ufo_v1_ufo_proto_rawDesc), len(file_ufo_v1_ufo_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ufo_v1_ufo_proto_goTypes,
 		DependencyIndexes: file_ufo_v1_ufo_proto_depIdxs,
+		EnumInfos:         file_ufo_v1_ufo_proto_enumTypes,
 		MessageInfos:      file_ufo_v1_ufo_proto_msgTypes,
 	}.Build()
 	File_ufo_v1_ufo_proto = out.File
@@ -22,10 +22,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UFOService_Create_FullMethodName = "/ufo.v1.UFOService/Create"
-	UFOService_Get_FullMethodName    = "/ufo.v1.UFOService/Get"
-	UFOService_Update_FullMethodName = "/ufo.v1.UFOService/Update"
-	UFOService_Delete_FullMethodName = "/ufo.v1.UFOService/Delete"
+	UFOService_Create_FullMethodName             = "/ufo.v1.UFOService/Create"
+	UFOService_Get_FullMethodName                = "/ufo.v1.UFOService/Get"
+	UFOService_Update_FullMethodName             = "/ufo.v1.UFOService/Update"
+	UFOService_Delete_FullMethodName             = "/ufo.v1.UFOService/Delete"
+	UFOService_WatchSightings_FullMethodName     = "/ufo.v1.UFOService/WatchSightings"
+	UFOService_Search_FullMethodName             = "/ufo.v1.UFOService/Search"
+	UFOService_GetStats_FullMethodName           = "/ufo.v1.UFOService/GetStats"
+	UFOService_UploadAttachment_FullMethodName   = "/ufo.v1.UFOService/UploadAttachment"
+	UFOService_DownloadAttachment_FullMethodName = "/ufo.v1.UFOService/DownloadAttachment"
 )
 
 // UFOServiceClient is the client API for UFOService service.
@@ -42,6 +47,17 @@ type UFOServiceClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchSightings транслирует события изменения наблюдений НЛО по мере их появления
+	WatchSightings(ctx context.Context, in *WatchSightingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SightingEvent], error)
+	// Search выполняет полнотекстовый поиск по месту и описанию наблюдений, самые релевантные первыми
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// GetStats возвращает количество наблюдений за период, сгруппированное по времени, цвету или звуку
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// UploadAttachment принимает вложение (фото или видео) к наблюдению по частям:
+	// первое сообщение содержит метаданные, следующие - содержимое файла
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	// DownloadAttachment отдает вложение наблюдения: первое сообщение содержит метаданные, следующие - содержимое
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
 }
 
 type uFOServiceClient struct {
@@ -92,6 +108,77 @@ func (c *uFOServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts .
 	return out, nil
 }
 
+func (c *uFOServiceClient) WatchSightings(ctx context.Context, in *WatchSightingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SightingEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[0], UFOService_WatchSightings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSightingsRequest, SightingEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_WatchSightingsClient = grpc.ServerStreamingClient[SightingEvent]
+
+func (c *uFOServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, UFOService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, UFOService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[1], UFOService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *uFOServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[2], UFOService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
 // UFOServiceServer is the server API for UFOService service.
 // All implementations must embed UnimplementedUFOServiceServer
 // for forward compatibility.
@@ -106,6 +193,17 @@ type UFOServiceServer interface {
 	Update(context.Context, *UpdateRequest) (*emptypb.Empty, error)
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// WatchSightings транслирует события изменения наблюдений НЛО по мере их появления
+	WatchSightings(*WatchSightingsRequest, grpc.ServerStreamingServer[SightingEvent]) error
+	// Search выполняет полнотекстовый поиск по месту и описанию наблюдений, самые релевантные первыми
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// GetStats возвращает количество наблюдений за период, сгруппированное по времени, цвету или звуку
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// UploadAttachment принимает вложение (фото или видео) к наблюдению по частям:
+	// первое сообщение содержит метаданные, следующие - содержимое файла
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	// DownloadAttachment отдает вложение наблюдения: первое сообщение содержит метаданные, следующие - содержимое
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
 	mustEmbedUnimplementedUFOServiceServer()
 }
 
@@ -128,6 +226,21 @@ func (UnimplementedUFOServiceServer) Update(context.Context, *UpdateRequest) (*e
 func (UnimplementedUFOServiceServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUFOServiceServer) WatchSightings(*WatchSightingsRequest, grpc.ServerStreamingServer[SightingEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSightings not implemented")
+}
+func (UnimplementedUFOServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedUFOServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedUFOServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedUFOServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
 func (UnimplementedUFOServiceServer) mustEmbedUnimplementedUFOServiceServer() {}
 func (UnimplementedUFOServiceServer) testEmbeddedByValue()                    {}
 
@@ -221,6 +334,71 @@ func _UFOService_Delete_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UFOService_WatchSightings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSightingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UFOServiceServer).WatchSightings(m, &grpc.GenericServerStream[WatchSightingsRequest, SightingEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_WatchSightingsServer = grpc.ServerStreamingServer[SightingEvent]
+
+func _UFOService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UFOServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _UFOService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UFOServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
 // UFOService_ServiceDesc is the grpc.ServiceDesc for UFOService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -244,7 +422,31 @@ var UFOService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UFOService_Delete_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _UFOService_Search_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _UFOService_GetStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSightings",
+			Handler:       _UFOService_WatchSightings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _UFOService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _UFOService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ufo/v1/ufo.proto",
 }